@@ -0,0 +1,226 @@
+// Package apierror turns responses of the NetBird management API into typed
+// errors and Terraform diagnostics.
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Kind classifies an API error by what the caller can do about it.
+type Kind int
+
+const (
+	Unknown Kind = iota
+	NotFound
+	Conflict
+	Unauthorized
+	Forbidden
+	Validation
+	RateLimited
+	Server
+)
+
+func (k Kind) String() string {
+	switch k {
+	case NotFound:
+		return "not found"
+	case Conflict:
+		return "conflict"
+	case Unauthorized:
+		return "unauthorized"
+	case Forbidden:
+		return "forbidden"
+	case Validation:
+		return "validation"
+	case RateLimited:
+		return "rate limited"
+	case Server:
+		return "server"
+	default:
+		return "unknown"
+	}
+}
+
+// Error is an unsuccessful response of the management API.
+type Error struct {
+	Kind       Kind
+	StatusCode int
+	Method     string
+	Path       string
+	// ResourceID is the ID of the object the request was about, if any.
+	ResourceID string
+	// Message is the message of NetBird's JSON error body, or the raw body
+	// when it isn't JSON.
+	Message string
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Check returns an *Error when res isn't successful, and nil otherwise. The
+// management API answers every successful call with 200 OK, so any other
+// status is an error.
+func Check(res *http.Response, body []byte, resourceId string) error {
+	if res == nil {
+		return &Error{Kind: Unknown, ResourceID: resourceId, Message: "no response received"}
+	}
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	apiErr := newError(res, resourceId)
+	apiErr.Kind = kindOf(res.StatusCode)
+
+	// NetBird reports errors as {"message":"group not found","code":404}.
+	var errorBody struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &errorBody); err == nil {
+		apiErr.Message = errorBody.Message
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	// Some missing objects are reported with another status but a 404 code in
+	// the error body. Server errors, conflicts, denied and rate limited
+	// requests keep their kind, whatever the body says.
+	if errorBody.Code == http.StatusNotFound && (apiErr.Kind == Unknown || apiErr.Kind == Validation) {
+		apiErr.Kind = NotFound
+	}
+
+	return apiErr
+}
+
+// CheckJSON is Check for responses whose JSON200 is used afterwards. The
+// generated parsers only decode bodies served with a JSON content type, so a
+// 200 with another one, such as an HTML page of a proxy, leaves json200 nil
+// and is reported as an error too.
+func CheckJSON[T any](res *http.Response, body []byte, json200 *T, resourceId string) error {
+	if err := Check(res, body, resourceId); err != nil {
+		return err
+	}
+	if json200 != nil {
+		return nil
+	}
+
+	apiErr := newError(res, resourceId)
+	apiErr.Kind = Unknown
+	apiErr.Message = fmt.Sprintf("expected a JSON body, got content type %q", res.Header.Get("Content-Type"))
+	return apiErr
+}
+
+func newError(res *http.Response, resourceId string) *Error {
+	apiErr := &Error{
+		StatusCode: res.StatusCode,
+		ResourceID: resourceId,
+	}
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		apiErr.Path = res.Request.URL.Path
+	}
+	return apiErr
+}
+
+func kindOf(statusCode int) Kind {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return NotFound
+	case statusCode == http.StatusConflict:
+		return Conflict
+	case statusCode == http.StatusUnauthorized:
+		return Unauthorized
+	case statusCode == http.StatusForbidden:
+		return Forbidden
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusUnprocessableEntity:
+		return Validation
+	case statusCode == http.StatusTooManyRequests:
+		return RateLimited
+	case statusCode >= 500:
+		return Server
+	default:
+		return Unknown
+	}
+}
+
+// KindOf returns the kind of err, or Unknown when err isn't an *Error.
+func KindOf(err error) Kind {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Kind
+	}
+	return Unknown
+}
+
+// IsNotFound reports whether err says the requested object doesn't exist.
+func IsNotFound(err error) bool {
+	return KindOf(err) == NotFound
+}
+
+// Diagnostic converts err into an error diagnostic explaining what went wrong
+// and how to fix it.
+func Diagnostic(err error) diag.Diagnostic {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return diag.NewErrorDiagnostic("Unexpected NetBird API error", err.Error())
+	}
+
+	summary, hint := describe(apiErr.Kind)
+
+	var detail strings.Builder
+	detail.WriteString(hint + "\n\n")
+	if apiErr.Method != "" {
+		fmt.Fprintf(&detail, "Request: %s %s\n", apiErr.Method, apiErr.Path)
+	}
+	if apiErr.ResourceID != "" {
+		fmt.Fprintf(&detail, "Resource ID: %s\n", apiErr.ResourceID)
+	}
+	if apiErr.StatusCode != 0 {
+		fmt.Fprintf(&detail, "Status: %d %s\n", apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+	}
+	if apiErr.Message != "" {
+		fmt.Fprintf(&detail, "Message: %s\n", apiErr.Message)
+	}
+
+	return diag.NewErrorDiagnostic(summary, strings.TrimSuffix(detail.String(), "\n"))
+}
+
+func describe(kind Kind) (summary string, hint string) {
+	switch kind {
+	case NotFound:
+		return "NetBird object not found",
+			"The object doesn't exist, it may have been deleted outside of Terraform."
+	case Conflict:
+		return "NetBird object conflict",
+			"The request conflicts with an existing object, for example one with the same name."
+	case Unauthorized:
+		return "NetBird authentication failed",
+			"Check that the token configured in the provider is valid and hasn't expired."
+	case Forbidden:
+		return "NetBird permission denied",
+			"The user owning the token isn't allowed to do this, check its role."
+	case Validation:
+		return "NetBird rejected the request",
+			"The API considers the request invalid, check the arguments of the resource."
+	case RateLimited:
+		return "NetBird rate limit exceeded",
+			"Too many requests were sent to the API, retry later or send fewer requests."
+	case Server:
+		return "NetBird server error",
+			"The management server failed to handle the request, retry later or check its logs."
+	default:
+		return "Unexpected NetBird API response",
+			"The API returned an unexpected response."
+	}
+}
@@ -0,0 +1,233 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func newResponse(statusCode int, contentType string) *http.Response {
+	res := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{Scheme: "https", Host: "api.netbird.io", Path: "/api/groups/g1"},
+		},
+	}
+	if contentType != "" {
+		res.Header.Set("Content-Type", contentType)
+	}
+	return res
+}
+
+func TestCheckSuccess(t *testing.T) {
+	if err := Check(newResponse(http.StatusOK, "application/json"), []byte(`{}`), "g1"); err != nil {
+		t.Errorf("Check() = %v, want nil", err)
+	}
+}
+
+func TestCheckNoResponse(t *testing.T) {
+	err := Check(nil, nil, "g1")
+	if KindOf(err) != Unknown {
+		t.Errorf("kind = %s, want %s", KindOf(err), Unknown)
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.ResourceID != "g1" {
+		t.Errorf("Check() = %#v, want an *Error for g1", err)
+	}
+}
+
+func TestCheckKind(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       Kind
+	}{
+		{"not found", http.StatusNotFound, `{"message":"group not found","code":404}`, NotFound},
+		{"not found without body", http.StatusNotFound, ``, NotFound},
+		{"conflict", http.StatusConflict, `{"message":"group already exists","code":409}`, Conflict},
+		{"unauthorized", http.StatusUnauthorized, `{"message":"token invalid","code":401}`, Unauthorized},
+		{"forbidden", http.StatusForbidden, `{"message":"permission denied","code":403}`, Forbidden},
+		{"bad request", http.StatusBadRequest, `{"message":"invalid name","code":400}`, Validation},
+		{"unprocessable", http.StatusUnprocessableEntity, `{"message":"invalid name","code":422}`, Validation},
+		{"rate limited", http.StatusTooManyRequests, ``, RateLimited},
+		{"server", http.StatusInternalServerError, `{"message":"internal error","code":500}`, Server},
+		{"bad gateway", http.StatusBadGateway, `<html>bad gateway</html>`, Server},
+		{"other", http.StatusTeapot, ``, Unknown},
+
+		// The error body code marks missing objects reported with another status.
+		{"not found code with bad request", http.StatusBadRequest, `{"message":"peer not found","code":404}`, NotFound},
+		{"not found code with unprocessable", http.StatusUnprocessableEntity, `{"message":"user not found","code":404}`, NotFound},
+		{"not found code with other status", http.StatusTeapot, `{"message":"route not found","code":404}`, NotFound},
+
+		// ...but never turns server errors, conflicts or denied requests into one.
+		{"not found code with server error", http.StatusInternalServerError, `{"message":"group not found","code":404}`, Server},
+		{"not found code with conflict", http.StatusConflict, `{"message":"group not found","code":404}`, Conflict},
+		{"not found code with unauthorized", http.StatusUnauthorized, `{"message":"user not found","code":404}`, Unauthorized},
+		{"not found code with forbidden", http.StatusForbidden, `{"message":"user not found","code":404}`, Forbidden},
+		{"not found code with rate limited", http.StatusTooManyRequests, `{"code":404}`, RateLimited},
+
+		// The message alone doesn't make an error a missing object.
+		{"not found message", http.StatusBadRequest, `{"message":"group not found","code":400}`, Validation},
+		{"not found message with server error", http.StatusInternalServerError, `{"message":"account not found"}`, Server},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(newResponse(tt.statusCode, "application/json"), []byte(tt.body), "g1")
+			if got := KindOf(err); got != tt.want {
+				t.Errorf("kind = %s, want %s", got, tt.want)
+			}
+			if got := IsNotFound(err); got != (tt.want == NotFound) {
+				t.Errorf("IsNotFound() = %t, want %t", got, tt.want == NotFound)
+			}
+		})
+	}
+}
+
+func TestCheckError(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantMessage string
+	}{
+		{"json body", `{"message":"group not found","code":404}`, "group not found"},
+		{"raw body", "  page not found\n", "page not found"},
+		{"empty body", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(newResponse(http.StatusNotFound, "application/json"), []byte(tt.body), "g1")
+
+			var apiErr *Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Check() = %v, want an *Error", err)
+			}
+			want := Error{
+				Kind:       NotFound,
+				StatusCode: http.StatusNotFound,
+				Method:     http.MethodGet,
+				Path:       "/api/groups/g1",
+				ResourceID: "g1",
+				Message:    tt.wantMessage,
+			}
+			if *apiErr != want {
+				t.Errorf("Check() = %#v, want %#v", *apiErr, want)
+			}
+		})
+	}
+}
+
+func TestErrorString(t *testing.T) {
+	err := &Error{StatusCode: http.StatusNotFound, Method: http.MethodGet, Path: "/api/groups/g1", Message: "group not found"}
+	if got, want := err.Error(), "GET /api/groups/g1: 404 Not Found: group not found"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	err.Message = ""
+	if got, want := err.Error(), "GET /api/groups/g1: 404 Not Found"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestCheckJSON(t *testing.T) {
+	type group struct{ Name string }
+
+	t.Run("json body", func(t *testing.T) {
+		err := CheckJSON(newResponse(http.StatusOK, "application/json"), []byte(`{"Name":"dev"}`), &group{Name: "dev"}, "g1")
+		if err != nil {
+			t.Errorf("CheckJSON() = %v, want nil", err)
+		}
+	})
+
+	t.Run("other content type", func(t *testing.T) {
+		var json200 *group
+		err := CheckJSON(newResponse(http.StatusOK, "text/html"), []byte(`<html></html>`), json200, "g1")
+
+		var apiErr *Error
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("CheckJSON() = %v, want an *Error", err)
+		}
+		if apiErr.Kind != Unknown || apiErr.StatusCode != http.StatusOK || apiErr.ResourceID != "g1" {
+			t.Errorf("CheckJSON() = %#v, want an unknown error for g1 with status 200", *apiErr)
+		}
+		if want := `expected a JSON body, got content type "text/html"`; apiErr.Message != want {
+			t.Errorf("message = %q, want %q", apiErr.Message, want)
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		var json200 *group
+		err := CheckJSON(newResponse(http.StatusNotFound, "application/json"), []byte(`{"message":"group not found","code":404}`), json200, "g1")
+		if !IsNotFound(err) {
+			t.Errorf("CheckJSON() = %v, want a not found error", err)
+		}
+	})
+}
+
+func TestKindOf(t *testing.T) {
+	wrapped := fmt.Errorf("reading group: %w", &Error{Kind: NotFound})
+	if !IsNotFound(wrapped) {
+		t.Errorf("IsNotFound(%v) = false, want true", wrapped)
+	}
+	if got := KindOf(errors.New("connection refused")); got != Unknown {
+		t.Errorf("KindOf() = %s, want %s", got, Unknown)
+	}
+	if IsNotFound(nil) {
+		t.Error("IsNotFound(nil) = true, want false")
+	}
+}
+
+func TestDiagnostic(t *testing.T) {
+	t.Run("api error", func(t *testing.T) {
+		err := Check(newResponse(http.StatusForbidden, "application/json"), []byte(`{"message":"permission denied","code":403}`), "g1")
+		d := Diagnostic(err)
+
+		if d.Severity() != diag.SeverityError {
+			t.Errorf("severity = %s, want %s", d.Severity(), diag.SeverityError)
+		}
+		if want := "NetBird permission denied"; d.Summary() != want {
+			t.Errorf("summary = %q, want %q", d.Summary(), want)
+		}
+		want := strings.Join([]string{
+			"The user owning the token isn't allowed to do this, check its role.",
+			"",
+			"Request: GET /api/groups/g1",
+			"Resource ID: g1",
+			"Status: 403 Forbidden",
+			"Message: permission denied",
+		}, "\n")
+		if d.Detail() != want {
+			t.Errorf("detail = %q, want %q", d.Detail(), want)
+		}
+	})
+
+	t.Run("without request", func(t *testing.T) {
+		d := Diagnostic(&Error{Kind: Unknown, Message: "no response received"})
+		if want := "The API returned an unexpected response.\n\nMessage: no response received"; d.Detail() != want {
+			t.Errorf("detail = %q, want %q", d.Detail(), want)
+		}
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		d := Diagnostic(errors.New("connection refused"))
+		if d.Summary() != "Unexpected NetBird API error" || d.Detail() != "connection refused" {
+			t.Errorf("Diagnostic() = %q: %q, want the error as detail", d.Summary(), d.Detail())
+		}
+	})
+
+	for kind := Unknown; kind <= Server; kind++ {
+		summary, hint := describe(kind)
+		if summary == "" || hint == "" {
+			t.Errorf("describe(%s) = %q, %q, want a summary and a hint", kind, summary, hint)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Options configures the connection to the management server.
+type Options struct {
+	// CACertPEM holds extra certificate authorities trusted besides the ones
+	// of the system.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM authenticate the provider with mutual
+	// TLS when both are set.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+	// ProxyURL overrides the proxy taken from the HTTP_PROXY, HTTPS_PROXY and
+	// NO_PROXY environment variables.
+	ProxyURL *url.URL
+	// Timeout limits the duration of a single request, 0 means no limit.
+	Timeout time.Duration
+}
+
+// New returns an HTTP client configured with opts.
+func New(opts Options) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	if len(opts.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(opts.CACertPEM) {
+			return nil, errors.New("no valid PEM encoded certificate found in the CA certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(opts.ClientCertPEM) > 0 || len(opts.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(opts.ClientCertPEM, opts.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	if opts.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(opts.ProxyURL)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Limits caps the load the provider puts on the management API.
+type Limits struct {
+	// RequestsPerSecond is the sustained request rate, 0 means unlimited.
+	RequestsPerSecond float64
+	// MaxConcurrentRequests is the number of requests in flight at once, 0
+	// means unlimited.
+	MaxConcurrentRequests int
+}
+
+type limitDoer struct {
+	next   Doer
+	bucket *tokenBucket
+	slots  chan struct{}
+}
+
+// WithLimits wraps next so requests respect limits. A request waits for a
+// token of the rate limiter and then for a free slot, which is released once
+// its response body is closed. Wrap it inside WithRetries so every attempt
+// counts against the limits.
+func WithLimits(next Doer, limits Limits) Doer {
+	if limits.RequestsPerSecond <= 0 && limits.MaxConcurrentRequests <= 0 {
+		return next
+	}
+
+	d := &limitDoer{next: next}
+	if limits.RequestsPerSecond > 0 {
+		d.bucket = &tokenBucket{rate: limits.RequestsPerSecond, tokens: 1, last: time.Now()}
+	}
+	if limits.MaxConcurrentRequests > 0 {
+		d.slots = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+	return d
+}
+
+func (d *limitDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if d.bucket != nil {
+		if err := d.bucket.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.slots == nil {
+		return d.next.Do(req)
+	}
+
+	select {
+	case d.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	release := func() { <-d.slots }
+
+	res, err := d.next.Do(req)
+	if err != nil {
+		release()
+		return res, err
+	}
+
+	res.Body = &releasingBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+// releasingBody frees the slot of a request when its body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// tokenBucket allows rate requests per second, without bursts.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = min(1, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// okDoer answers every request with an empty 200 response.
+var okDoer = doerFunc(func(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+})
+
+// doAsync sends a request through doer in the background and returns its
+// outcome once done.
+func doAsync(doer Doer, req *http.Request) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		res, err := doer.Do(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		done <- err
+	}()
+	return done
+}
+
+func newTestRequest(ctx context.Context) *http.Request {
+	return httptest.NewRequest(http.MethodGet, "http://netbird.test/api/peers", nil).WithContext(ctx)
+}
+
+func TestWithLimitsUnlimited(t *testing.T) {
+	doer := WithLimits(okDoer, Limits{})
+	if _, ok := doer.(doerFunc); !ok {
+		t.Errorf("WithLimits() = %T, want the wrapped doer", doer)
+	}
+}
+
+func TestWithLimitsReleasesSlotOnClose(t *testing.T) {
+	doer := WithLimits(okDoer, Limits{MaxConcurrentRequests: 1})
+
+	res, err := doer.Do(newTestRequest(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := doAsync(doer, newTestRequest(context.Background()))
+	select {
+	case <-done:
+		t.Fatal("second request was sent while the first body was still open")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	res.Body.Close()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("second request wasn't sent after the first body was closed")
+	}
+}
+
+func TestWithLimitsReleasesSlotOnce(t *testing.T) {
+	doer := WithLimits(okDoer, Limits{MaxConcurrentRequests: 2})
+
+	first, err := doer.Do(newTestRequest(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := doer.Do(newTestRequest(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Closing a body twice must not free the slot of another request.
+	first.Body.Close()
+	first.Body.Close()
+
+	third, err := doer.Do(newTestRequest(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer third.Body.Close()
+	defer second.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := doer.Do(newTestRequest(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWithLimitsReleasesSlotOnError(t *testing.T) {
+	failing := doerFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection reset")
+	})
+	doer := WithLimits(failing, Limits{MaxConcurrentRequests: 1})
+
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-doAsync(doer, newTestRequest(context.Background())):
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("request %d is waiting for a slot that wasn't released", i+1)
+		}
+	}
+}
+
+func TestWithLimitsContextCanceledWaitingForSlot(t *testing.T) {
+	doer := WithLimits(okDoer, Limits{MaxConcurrentRequests: 1})
+
+	res, err := doer.Do(newTestRequest(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := doer.Do(newTestRequest(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWithLimitsRequestsPerSecond(t *testing.T) {
+	doer := WithLimits(okDoer, Limits{RequestsPerSecond: 20})
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		res, err := doer.Do(newTestRequest(context.Background()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+
+	// The first request is sent right away, the other four 50ms apart.
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("5 requests took %s, want at least 200ms", elapsed)
+	}
+}
+
+func TestWithLimitsContextCanceledWaitingForToken(t *testing.T) {
+	doer := WithLimits(okDoer, Limits{RequestsPerSecond: 0.1})
+
+	res, err := doer.Do(newTestRequest(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := doer.Do(newTestRequest(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
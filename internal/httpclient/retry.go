@@ -0,0 +1,172 @@
+// Package httpclient builds the HTTP client the provider hands to the SDK.
+package httpclient
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Doer sends HTTP requests, it matches sdk.HttpRequestDoer.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RetryPolicy configures how failed requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt, 0
+	// disables retries.
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, doubled for every
+	// following retry up to MaxBackoff.
+	MinBackoff time.Duration
+	// MaxBackoff is the longest wait between two attempts, responses asking
+	// for a longer Retry-After aren't retried.
+	MaxBackoff time.Duration
+	// Jitter randomizes each wait between half and all of the backoff, so
+	// parallel requests don't retry in lockstep.
+	Jitter bool
+}
+
+// DefaultRetryPolicy is used when the provider doesn't configure retries.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Second,
+	MaxBackoff: 30 * time.Second,
+	Jitter:     true,
+}
+
+type retryDoer struct {
+	next   Doer
+	policy RetryPolicy
+}
+
+// WithRetries wraps next so failed requests are retried according to policy.
+//
+// Requests are only retried when that is safe: rate limited (429) and
+// unavailable (503) responses and refused connections never reached the
+// API, bad gateway (502) and gateway timeout (504) responses and other
+// network failures are only retried for idempotent methods. A Retry-After
+// header overrides the backoff, a response asking to wait longer than
+// MaxBackoff is returned without retrying.
+func WithRetries(next Doer, policy RetryPolicy) Doer {
+	return &retryDoer{next: next, policy: policy}
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := d.next.Do(req)
+		if attempt >= d.policy.MaxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		wait := d.backoff(attempt)
+		if res != nil {
+			if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+				// Waiting longer would stall the apply, and the request would
+				// be sent again with an Authorization header that may have
+				// expired in the meantime.
+				if retryAfter > d.policy.MaxBackoff {
+					return res, err
+				}
+				wait = retryAfter
+			}
+		}
+
+		// The body of a request can only be sent again when it can be
+		// recreated, which is the case for every request built by the SDK.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return res, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return res, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (d *retryDoer) backoff(attempt int) time.Duration {
+	wait := d.policy.MinBackoff
+	for i := 0; i < attempt && wait < d.policy.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > d.policy.MaxBackoff {
+		wait = d.policy.MaxBackoff
+	}
+
+	if d.policy.Jitter && wait > 1 {
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	}
+	return wait
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		return isIdempotent(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header, given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
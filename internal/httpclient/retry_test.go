@@ -0,0 +1,369 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Millisecond,
+	MaxBackoff: 10 * time.Millisecond,
+}
+
+func TestWithRetriesStatus(t *testing.T) {
+	tests := []struct {
+		method       string
+		status       int
+		wantAttempts int32
+	}{
+		{http.MethodGet, http.StatusOK, 1},
+		{http.MethodGet, http.StatusNotFound, 1},
+		{http.MethodGet, http.StatusInternalServerError, 1},
+		{http.MethodGet, http.StatusTooManyRequests, 4},
+		{http.MethodPost, http.StatusTooManyRequests, 4},
+		{http.MethodGet, http.StatusServiceUnavailable, 4},
+		{http.MethodPost, http.StatusServiceUnavailable, 4},
+		{http.MethodGet, http.StatusBadGateway, 4},
+		{http.MethodPut, http.StatusBadGateway, 4},
+		{http.MethodDelete, http.StatusGatewayTimeout, 4},
+		{http.MethodPost, http.StatusBadGateway, 1},
+		{http.MethodPost, http.StatusGatewayTimeout, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+http.StatusText(tt.status), func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(tt.method, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := WithRetries(server.Client(), testRetryPolicy).Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.status)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestWithRetriesSucceedsAfterRetry(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := WithRetries(server.Client(), testRetryPolicy).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestWithRetriesDisabled(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := WithRetries(server.Client(), RetryPolicy{}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestWithRetriesReplaysBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// http.NewRequest sets GetBody for a strings.Reader body.
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := WithRetries(server.Client(), testRetryPolicy).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if len(bodies) != 2 {
+		t.Fatalf("attempts = %d, want 2", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"name":"test"}` {
+			t.Errorf("body of attempt %d = %q, want %q", i+1, body, `{"name":"test"}`)
+		}
+	}
+}
+
+func TestWithRetriesWithoutGetBody(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, io.NopCloser(strings.NewReader("body")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := WithRetries(server.Client(), testRetryPolicy).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestWithRetriesRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := testRetryPolicy
+	policy.MaxBackoff = 2 * time.Second
+	start := time.Now()
+	res, err := WithRetries(server.Client(), policy).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least 1s", elapsed)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
+
+func TestWithRetriesRetryAfterAboveMaxBackoff(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	res, err := WithRetries(server.Client(), testRetryPolicy).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", res.StatusCode, http.StatusTooManyRequests)
+	}
+	if got := res.Header.Get("Retry-After"); got != "3600" {
+		t.Errorf("Retry-After = %q, want the response of the server", got)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("returned after %s, want right away", elapsed)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestWithRetriesContextCanceled(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := testRetryPolicy
+	policy.MaxBackoff = time.Minute
+	start := time.Now()
+	res, err := WithRetries(server.Client(), policy).Do(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if res != nil {
+		t.Errorf("res = %v, want nil", res)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("returned after %s, want the wait to be cut short", elapsed)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestWithRetriesConnectionRefused(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	// A refused connection never reached the API, so even a POST is retried.
+	var attempts atomic.Int32
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		return http.DefaultClient.Do(req)
+	})
+
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := WithRetries(doer, testRetryPolicy).Do(req); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := attempts.Load(); got != 4 {
+		t.Errorf("attempts = %d, want 4", got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := &retryDoer{policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}}
+
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for attempt, want := range want {
+		if got := d.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+
+	// Large attempts must not overflow.
+	if got := d.backoff(100); got != 5*time.Second {
+		t.Errorf("backoff(100) = %s, want %s", got, 5*time.Second)
+	}
+}
+
+func TestBackoffJitter(t *testing.T) {
+	d := &retryDoer{policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second, Jitter: true}}
+
+	ceilings := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for attempt, ceiling := range ceilings {
+		for i := 0; i < 100; i++ {
+			if got := d.backoff(attempt); got < ceiling/2 || got > ceiling {
+				t.Fatalf("backoff(%d) = %s, want between %s and %s", attempt, got, ceiling/2, ceiling)
+			}
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{"empty", "", 0, false},
+		{"seconds", "120", 120 * time.Second, true},
+		{"zero", "0", 0, true},
+		{"negative", "-1", 0, false},
+		{"invalid", "soon", 0, false},
+		{"past date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("parseRetryAfter(%q) = %s, %t, want %s, %t", tt.value, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	t.Run("future date", func(t *testing.T) {
+		got, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		if !ok || got <= 58*time.Second || got > time.Minute {
+			t.Errorf("parseRetryAfter() = %s, %t, want about 1m, true", got, ok)
+		}
+	})
+}
+
+// doerFunc adapts a function to the Doer interface.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,146 @@
+// Package oidc fetches access tokens with the OAuth2 client credentials grant,
+// for NetBird deployments that authenticate API calls through an identity
+// provider instead of personal access tokens.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expiryDelta is how long before its expiry a token is refreshed, so it
+// doesn't expire while a request is in flight.
+const expiryDelta = 30 * time.Second
+
+// ClientCredentials is a token source for the client credentials grant. It
+// caches the token and is safe for concurrent use, so a single instance can
+// be shared by every API call of the provider.
+type ClientCredentials struct {
+	// IssuerURL is used to discover the token endpoint when TokenURL is empty.
+	IssuerURL    string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Audience     string
+	Scopes       []string
+	// HTTPClient sends the discovery and token requests, http.DefaultClient
+	// is used when nil.
+	HTTPClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// Token returns a valid access token, requesting a new one when the cached
+// token is missing or about to expire.
+func (c *ClientCredentials) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (c.expiry.IsZero() || time.Now().Add(expiryDelta).Before(c.expiry)) {
+		return c.token, nil
+	}
+
+	if c.TokenURL == "" {
+		tokenURL, err := c.discoverTokenURL(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.TokenURL = tokenURL
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+	}
+	if c.Audience != "" {
+		form.Set("audience", c.Audience)
+	}
+	if len(c.Scopes) > 0 {
+		form.Set("scope", strings.Join(c.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := c.do(req, &token); err != nil {
+		return "", fmt.Errorf("failed to fetch access token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("failed to fetch access token: %s returned no access_token", c.TokenURL)
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return "", fmt.Errorf("failed to fetch access token: unsupported token type %q", token.TokenType)
+	}
+
+	c.token = token.AccessToken
+	c.expiry = time.Time{}
+	if token.ExpiresIn > 0 {
+		c.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return c.token, nil
+}
+
+// discoverTokenURL reads the token endpoint from the OpenID configuration of
+// the issuer.
+func (c *ClientCredentials) discoverTokenURL(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.IssuerURL, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var config struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	if err := c.do(req, &config); err != nil {
+		return "", fmt.Errorf("failed to discover token endpoint: %w", err)
+	}
+	if config.TokenEndpoint == "" {
+		return "", fmt.Errorf("failed to discover token endpoint: issuer %s has no token_endpoint", c.IssuerURL)
+	}
+
+	return config.TokenEndpoint, nil
+}
+
+func (c *ClientCredentials) do(req *http.Request, v any) error {
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s: %s", req.Method, req.URL, res.Status, strings.TrimSpace(string(body)))
+	}
+
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,225 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// testIssuer is an identity provider that hands out numbered tokens.
+type testIssuer struct {
+	*httptest.Server
+
+	expiresIn   int64
+	discoveries atomic.Int32
+	requests    atomic.Int32
+	forms       chan map[string]string
+}
+
+func newTestIssuer(t *testing.T, expiresIn int64) *testIssuer {
+	t.Helper()
+
+	issuer := &testIssuer{expiresIn: expiresIn, forms: make(chan map[string]string, 10)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer.discoveries.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":         issuer.URL,
+			"token_endpoint": issuer.URL + "/oauth/token",
+		})
+	})
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		form := map[string]string{}
+		for key := range r.PostForm {
+			form[key] = r.PostForm.Get(key)
+		}
+		issuer.forms <- form
+
+		n := issuer.requests.Add(1)
+		token := map[string]any{
+			"access_token": fmt.Sprintf("token-%d", n),
+			"token_type":   "Bearer",
+		}
+		if issuer.expiresIn > 0 {
+			token["expires_in"] = issuer.expiresIn
+		}
+		_ = json.NewEncoder(w).Encode(token)
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+
+	return issuer
+}
+
+func TestTokenRequest(t *testing.T) {
+	issuer := newTestIssuer(t, 3600)
+	c := &ClientCredentials{
+		TokenURL:     issuer.URL + "/oauth/token",
+		ClientID:     "terraform",
+		ClientSecret: "secret",
+		Audience:     "netbird",
+		Scopes:       []string{"openid", "api"},
+		HTTPClient:   issuer.Client(),
+	}
+
+	token, err := c.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "token-1" {
+		t.Errorf("token = %q, want %q", token, "token-1")
+	}
+
+	form := <-issuer.forms
+	want := map[string]string{
+		"grant_type":    "client_credentials",
+		"client_id":     "terraform",
+		"client_secret": "secret",
+		"audience":      "netbird",
+		"scope":         "openid api",
+	}
+	for key, value := range want {
+		if form[key] != value {
+			t.Errorf("form %s = %q, want %q", key, form[key], value)
+		}
+	}
+	if got := issuer.discoveries.Load(); got != 0 {
+		t.Errorf("discoveries = %d, want 0 with a token URL", got)
+	}
+}
+
+func TestTokenOmitsEmptyAudienceAndScopes(t *testing.T) {
+	issuer := newTestIssuer(t, 3600)
+	c := &ClientCredentials{TokenURL: issuer.URL + "/oauth/token", ClientID: "terraform", HTTPClient: issuer.Client()}
+
+	if _, err := c.Token(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	form := <-issuer.forms
+	for _, key := range []string{"audience", "scope"} {
+		if _, ok := form[key]; ok {
+			t.Errorf("form has %s, want it omitted", key)
+		}
+	}
+}
+
+func TestTokenCache(t *testing.T) {
+	tests := []struct {
+		name         string
+		expiresIn    int64
+		wantRequests int32
+	}{
+		{"valid", 3600, 1},
+		{"without expiry", 0, 1},
+		{"expiring within 30s", 29, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer := newTestIssuer(t, tt.expiresIn)
+			c := &ClientCredentials{TokenURL: issuer.URL + "/oauth/token", HTTPClient: issuer.Client()}
+
+			for i := 0; i < 3; i++ {
+				if _, err := c.Token(context.Background()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := issuer.requests.Load(); got != tt.wantRequests {
+				t.Errorf("token requests = %d, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestTokenDiscovery(t *testing.T) {
+	// Expiring tokens are requested every time, the token endpoint is only
+	// discovered once.
+	issuer := newTestIssuer(t, 10)
+	c := &ClientCredentials{IssuerURL: issuer.URL + "/", HTTPClient: issuer.Client()}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Token(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if c.TokenURL != issuer.URL+"/oauth/token" {
+		t.Errorf("TokenURL = %q, want %q", c.TokenURL, issuer.URL+"/oauth/token")
+	}
+	if got := issuer.discoveries.Load(); got != 1 {
+		t.Errorf("discoveries = %d, want 1", got)
+	}
+	if got := issuer.requests.Load(); got != 2 {
+		t.Errorf("token requests = %d, want 2", got)
+	}
+}
+
+func TestTokenErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		wantErr string
+	}{
+		{
+			name: "error response",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			},
+			wantErr: "401 Unauthorized: {\"error\":\"invalid_client\"}",
+		},
+		{
+			name: "no access token",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"token_type":"Bearer"}`))
+			},
+			wantErr: "returned no access_token",
+		},
+		{
+			name: "unsupported token type",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"access_token":"token","token_type":"mac"}`))
+			},
+			wantErr: `unsupported token type "mac"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			c := &ClientCredentials{TokenURL: server.URL, HTTPClient: server.Client()}
+			_, err := c.Token(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenDiscoveryWithoutTokenEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"issuer":"https://idp.test"}`))
+	}))
+	defer server.Close()
+
+	c := &ClientCredentials{IssuerURL: server.URL, HTTPClient: server.Client()}
+	_, err := c.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "has no token_endpoint") {
+		t.Fatalf("err = %v, want it to contain %q", err, "has no token_endpoint")
+	}
+}
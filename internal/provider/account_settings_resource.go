@@ -0,0 +1,365 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*accountSettingsResource)(nil)
+var _ resource.ResourceWithImportState = (*accountSettingsResource)(nil)
+
+func NewAccountSettingsResource() resource.Resource {
+	return &accountSettingsResource{}
+}
+
+type accountSettingsResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type accountSettingsResourceModel struct {
+	GroupsPropagationEnabled   types.Bool   `tfsdk:"groups_propagation_enabled"`
+	Id                         types.String `tfsdk:"id"`
+	JwtAllowGroups             types.List   `tfsdk:"jwt_allow_groups"`
+	JwtGroupsClaimName         types.String `tfsdk:"jwt_groups_claim_name"`
+	JwtGroupsEnabled           types.Bool   `tfsdk:"jwt_groups_enabled"`
+	PeerApprovalEnabled        types.Bool   `tfsdk:"peer_approval_enabled"`
+	PeerLoginExpiration        types.Int64  `tfsdk:"peer_login_expiration"`
+	PeerLoginExpirationEnabled types.Bool   `tfsdk:"peer_login_expiration_enabled"`
+	RegularUsersViewBlocked    types.Bool   `tfsdk:"regular_users_view_blocked"`
+}
+
+func (r *accountSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_settings"
+}
+
+func (r *accountSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Settings of the account the provider is authenticated against. Only the attributes set in the configuration are managed, everything else is left untouched. Destroying this resource leaves the account settings as they are.",
+		Attributes: map[string]schema.Attribute{
+			"groups_propagation_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Allows propagate the new user auto groups to peers that belongs to the user",
+				MarkdownDescription: "Allows propagate the new user auto groups to peers that belongs to the user",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Account ID",
+				MarkdownDescription: "Account ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jwt_allow_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "List of groups to which users are allowed access",
+				MarkdownDescription: "List of groups to which users are allowed access",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jwt_groups_claim_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Name of the claim from which we extract groups names to add it to account groups.",
+				MarkdownDescription: "Name of the claim from which we extract groups names to add it to account groups.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jwt_groups_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Allows extract groups from JWT claim and add it to account groups.",
+				MarkdownDescription: "Allows extract groups from JWT claim and add it to account groups.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_approval_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "(Cloud only) Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.",
+				MarkdownDescription: "(Cloud only) Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_login_expiration": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Period of time after which peer login expires (seconds).",
+				MarkdownDescription: "Period of time after which peer login expires (seconds).",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_login_expiration_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Enables or disables peer login expiration globally. After peer's login has expired the user has to log in (authenticate). Applies only to peers that were added by a user (interactive SSO login).",
+				MarkdownDescription: "Enables or disables peer login expiration globally. After peer's login has expired the user has to log in (authenticate). Applies only to peers that were added by a user (interactive SSO login).",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"regular_users_view_blocked": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Allows blocking regular users from viewing parts of the system.",
+				MarkdownDescription: "Allows blocking regular users from viewing parts of the system.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *accountSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *accountSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config accountSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, diags := r.getAccount(ctx, "")
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	settings, diags := r.updateAccountSettings(ctx, account, config)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+}
+
+func (r *accountSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data accountSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, diags := r.getAccount(ctx, data.Id.ValueString())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	settings, diags := toAccountSettingsModel(ctx, account)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+}
+
+func (r *accountSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state accountSettingsResourceModel
+	var config accountSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, diags := r.getAccount(ctx, state.Id.ValueString())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	settings, diags := r.updateAccountSettings(ctx, account, config)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+}
+
+func (r *accountSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deleting the account would remove everything in it, the settings are
+	// only dropped from the state and left as they are.
+}
+
+func (r *accountSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getAccount returns the account with the given ID, or the account the
+// provider is authenticated against when id is empty.
+func (r *accountSettingsResource) getAccount(ctx context.Context, id string) (*sdk.Account, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.GetApiAccountsWithResponse(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke get accounts API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	if res.JSON200 == nil || len(*res.JSON200) == 0 {
+		diags.AddError("no account found", "The accounts API returned no account for the configured credentials.")
+		return nil, diags
+	}
+
+	accounts := *res.JSON200
+	if id == "" {
+		return &accounts[0], diags
+	}
+	for i := range accounts {
+		if accounts[i].Id == id {
+			return &accounts[i], diags
+		}
+	}
+
+	diags.AddError("account not found", fmt.Sprintf("No account with ID %q is accessible with the configured credentials.", id))
+	return nil, diags
+}
+
+// updateAccountSettings applies the attributes set in the configuration on
+// top of the current account settings, so settings that aren't managed by
+// Terraform are sent back unchanged.
+func (r *accountSettingsResource) updateAccountSettings(ctx context.Context, account *sdk.Account, config accountSettingsResourceModel) (accountSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.PutApiAccountsAccountIdWithResponse(ctx, account.Id, sdk.AccountRequest{
+		Settings: toAccountSettingsApiRequest(account.Settings, config),
+	})
+	if err != nil {
+		diags.AddError("failure to invoke update account API", err.Error())
+		return accountSettingsResourceModel{}, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, account.Id); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return accountSettingsResourceModel{}, diags
+	}
+
+	return toAccountSettingsModel(ctx, res.JSON200)
+}
+
+func toAccountSettingsApiRequest(settings sdk.AccountSettings, data accountSettingsResourceModel) sdk.AccountSettings {
+	if !data.GroupsPropagationEnabled.IsUnknown() && !data.GroupsPropagationEnabled.IsNull() {
+		settings.GroupsPropagationEnabled = data.GroupsPropagationEnabled.ValueBoolPointer()
+	}
+
+	if !data.JwtAllowGroups.IsUnknown() && !data.JwtAllowGroups.IsNull() {
+		jwtAllowGroups := toStringSlice(data.JwtAllowGroups)
+		settings.JwtAllowGroups = &jwtAllowGroups
+	}
+
+	if !data.JwtGroupsClaimName.IsUnknown() && !data.JwtGroupsClaimName.IsNull() {
+		settings.JwtGroupsClaimName = data.JwtGroupsClaimName.ValueStringPointer()
+	}
+
+	if !data.JwtGroupsEnabled.IsUnknown() && !data.JwtGroupsEnabled.IsNull() {
+		settings.JwtGroupsEnabled = data.JwtGroupsEnabled.ValueBoolPointer()
+	}
+
+	if !data.PeerApprovalEnabled.IsUnknown() && !data.PeerApprovalEnabled.IsNull() {
+		extra := sdk.AccountExtraSettings{}
+		if settings.Extra != nil {
+			extra = *settings.Extra
+		}
+		extra.PeerApprovalEnabled = data.PeerApprovalEnabled.ValueBoolPointer()
+		settings.Extra = &extra
+	}
+
+	if !data.PeerLoginExpiration.IsUnknown() && !data.PeerLoginExpiration.IsNull() {
+		settings.PeerLoginExpiration = int(data.PeerLoginExpiration.ValueInt64())
+	}
+
+	if !data.PeerLoginExpirationEnabled.IsUnknown() && !data.PeerLoginExpirationEnabled.IsNull() {
+		settings.PeerLoginExpirationEnabled = data.PeerLoginExpirationEnabled.ValueBool()
+	}
+
+	if !data.RegularUsersViewBlocked.IsUnknown() && !data.RegularUsersViewBlocked.IsNull() {
+		settings.RegularUsersViewBlocked = data.RegularUsersViewBlocked.ValueBool()
+	}
+
+	return settings
+}
+
+func toAccountSettingsModel(ctx context.Context, data *sdk.Account) (accountSettingsResourceModel, diag.Diagnostics) {
+	settings := data.Settings
+
+	model := accountSettingsResourceModel{
+		GroupsPropagationEnabled:   types.BoolValue(settings.GroupsPropagationEnabled != nil && *settings.GroupsPropagationEnabled),
+		Id:                         types.StringValue(data.Id),
+		JwtGroupsEnabled:           types.BoolValue(settings.JwtGroupsEnabled != nil && *settings.JwtGroupsEnabled),
+		PeerApprovalEnabled:        types.BoolValue(settings.Extra != nil && settings.Extra.PeerApprovalEnabled != nil && *settings.Extra.PeerApprovalEnabled),
+		PeerLoginExpiration:        types.Int64Value(int64(settings.PeerLoginExpiration)),
+		PeerLoginExpirationEnabled: types.BoolValue(settings.PeerLoginExpirationEnabled),
+		RegularUsersViewBlocked:    types.BoolValue(settings.RegularUsersViewBlocked),
+	}
+
+	claimName := ""
+	if settings.JwtGroupsClaimName != nil {
+		claimName = *settings.JwtGroupsClaimName
+	}
+	model.JwtGroupsClaimName = types.StringValue(claimName)
+
+	jwtAllowGroups := []string{}
+	if settings.JwtAllowGroups != nil {
+		jwtAllowGroups = *settings.JwtAllowGroups
+	}
+
+	var diags diag.Diagnostics
+	model.JwtAllowGroups, diags = types.ListValueFrom(ctx, types.StringType, jwtAllowGroups)
+
+	return model, diags
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*citiesDataSource)(nil)
+
+func NewCitiesDataSource() datasource.DataSource {
+	return &citiesDataSource{}
+}
+
+type citiesDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type citiesDataSourceModel struct {
+	Cities      []cityDataSourceModel `tfsdk:"cities"`
+	CountryCode types.String          `tfsdk:"country_code"`
+	GeonameIds  types.List            `tfsdk:"geoname_ids"`
+	Name        types.String          `tfsdk:"name"`
+}
+
+type cityDataSourceModel struct {
+	CityName  types.String `tfsdk:"city_name"`
+	GeonameId types.Int64  `tfsdk:"geoname_id"`
+}
+
+func (d *citiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cities"
+}
+
+func (d *citiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the cities of a country known to the geolocation database, for use in geo-location posture checks.",
+		Attributes: map[string]schema.Attribute{
+			"cities": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Cities matching the filters",
+				MarkdownDescription: "Cities matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"city_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Commonly used English name of the city",
+							MarkdownDescription: "Commonly used English name of the city",
+						},
+						"geoname_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Integer ID of the record in GeoNames database",
+							MarkdownDescription: "Integer ID of the record in GeoNames database",
+						},
+					},
+				},
+			},
+			"country_code": schema.StringAttribute{
+				Required:            true,
+				Description:         "2-letter ISO 3166-1 alpha-2 code of the country to list cities of",
+				MarkdownDescription: "2-letter ISO 3166-1 alpha-2 code of the country to list cities of",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 2),
+				},
+			},
+			"geoname_ids": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				Description:         "GeoNames IDs of the cities matching the filters",
+				MarkdownDescription: "GeoNames IDs of the cities matching the filters",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return cities with this English name, matched ignoring case",
+				MarkdownDescription: "Only return cities with this English name, matched ignoring case",
+			},
+		},
+	}
+}
+
+func (d *citiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *citiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data citiesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cities, diags := getCities(ctx, d.client, data.CountryCode.ValueString())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data.Cities = []cityDataSourceModel{}
+	geonameIds := []int64{}
+	for _, city := range cities {
+		if !data.Name.IsNull() && !strings.EqualFold(city.CityName, data.Name.ValueString()) {
+			continue
+		}
+
+		data.Cities = append(data.Cities, cityDataSourceModel{
+			CityName:  types.StringValue(city.CityName),
+			GeonameId: types.Int64Value(int64(city.GeonameId)),
+		})
+		geonameIds = append(geonameIds, int64(city.GeonameId))
+	}
+
+	if !data.Name.IsNull() && len(geonameIds) == 0 {
+		resp.Diagnostics.AddError("city not found", fmt.Sprintf("No city named %q exists in country %q.", data.Name.ValueString(), data.CountryCode.ValueString()))
+		return
+	}
+
+	data.GeonameIds, diags = types.ListValueFrom(ctx, types.Int64Type, geonameIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func getCities(ctx context.Context, client *sdk.ClientWithResponses, countryCode string) ([]sdk.City, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The spec declares a single city, but the API returns a list. Use the
+	// plain client so the generated parser doesn't fail decoding it, and
+	// decode the body by hand.
+	res, err := client.GetApiLocationsCountriesCountryCities(ctx, strings.ToUpper(countryCode))
+	if err != nil {
+		diags.AddError("failure to invoke list cities API", err.Error())
+		return nil, diags
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		diags.AddError("failure to read cities", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.Check(res, body, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	var cities []sdk.City
+	if err := json.Unmarshal(body, &cities); err != nil {
+		diags.AddError("failure to decode cities", err.Error())
+		return nil, diags
+	}
+
+	return cities, diags
+}
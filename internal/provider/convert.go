@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// toStringSlice converts a list of strings from the Terraform model into a
+// slice suitable for API requests. Unknown and null elements are left empty.
+func toStringSlice(data types.List) []string {
+	values := make([]string, len(data.Elements()))
+	for i, v := range data.Elements() {
+		if !v.IsUnknown() && !v.IsNull() {
+			value, ok := v.(types.String)
+			if ok {
+				values[i] = value.ValueString()
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*countriesDataSource)(nil)
+
+func NewCountriesDataSource() datasource.DataSource {
+	return &countriesDataSource{}
+}
+
+type countriesDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type countriesDataSourceModel struct {
+	Countries    []countryDataSourceModel `tfsdk:"countries"`
+	CountryCodes types.List               `tfsdk:"country_codes"`
+	Name         types.String             `tfsdk:"name"`
+}
+
+type countryDataSourceModel struct {
+	CountryCode types.String `tfsdk:"country_code"`
+	CountryName types.String `tfsdk:"country_name"`
+}
+
+func (d *countriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_countries"
+}
+
+func (d *countriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the countries known to the geolocation database, for use in geo-location posture checks.",
+		Attributes: map[string]schema.Attribute{
+			"countries": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Countries matching the filters",
+				MarkdownDescription: "Countries matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"country_code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "2-letter ISO 3166-1 alpha-2 code that represents the country",
+							MarkdownDescription: "2-letter ISO 3166-1 alpha-2 code that represents the country",
+						},
+						"country_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Commonly used English name of the country",
+							MarkdownDescription: "Commonly used English name of the country",
+						},
+					},
+				},
+			},
+			"country_codes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Codes of the countries matching the filters",
+				MarkdownDescription: "Codes of the countries matching the filters",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return the country with this English name, matched ignoring case",
+				MarkdownDescription: "Only return the country with this English name, matched ignoring case",
+			},
+		},
+	}
+}
+
+func (d *countriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *countriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data countriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	countries, diags := getCountries(ctx, d.client)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data.Countries = []countryDataSourceModel{}
+	countryCodes := []string{}
+	for _, country := range countries {
+		if !data.Name.IsNull() && !strings.EqualFold(country.CountryName, data.Name.ValueString()) {
+			continue
+		}
+
+		data.Countries = append(data.Countries, countryDataSourceModel{
+			CountryCode: types.StringValue(country.CountryCode),
+			CountryName: types.StringValue(country.CountryName),
+		})
+		countryCodes = append(countryCodes, country.CountryCode)
+	}
+
+	if !data.Name.IsNull() && len(countryCodes) == 0 {
+		resp.Diagnostics.AddError("country not found", fmt.Sprintf("No country named %q exists.", data.Name.ValueString()))
+		return
+	}
+
+	data.CountryCodes, diags = types.ListValueFrom(ctx, types.StringType, countryCodes)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func getCountries(ctx context.Context, client *sdk.ClientWithResponses) ([]sdk.Country, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The spec declares a list of plain country codes, but the API returns
+	// country objects. Use the plain client so the generated parser doesn't
+	// fail decoding them, and decode the body by hand.
+	res, err := client.GetApiLocationsCountries(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke list countries API", err.Error())
+		return nil, diags
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		diags.AddError("failure to read countries", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.Check(res, body, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	var countries []sdk.Country
+	if err := json.Unmarshal(body, &countries); err != nil {
+		diags.AddError("failure to decode countries", err.Error())
+		return nil, diags
+	}
+
+	return countries, diags
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+// dnsSettingsId is the fixed identifier of the account-wide DNS settings,
+// there is only ever one instance per account.
+const dnsSettingsId = "dns_settings"
+
+var _ resource.Resource = (*dnsSettingsResource)(nil)
+var _ resource.ResourceWithImportState = (*dnsSettingsResource)(nil)
+
+func NewDnsSettingsResource() resource.Resource {
+	return &dnsSettingsResource{}
+}
+
+type dnsSettingsResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type dnsSettingsResourceModel struct {
+	DisabledManagementGroups types.List   `tfsdk:"disabled_management_groups"`
+	Id                       types.String `tfsdk:"id"`
+}
+
+func (r *dnsSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_settings"
+}
+
+func (r *dnsSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Account-wide DNS settings. Creating this resource adopts the existing settings and destroying it restores an empty list of disabled management groups.",
+		Attributes: map[string]schema.Attribute{
+			"disabled_management_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "Groups whose DNS management is disabled",
+				MarkdownDescription: "Groups whose DNS management is disabled",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The identifier of the DNS settings, always `dns_settings`",
+				MarkdownDescription: "The identifier of the DNS settings, always `dns_settings`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *dnsSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *dnsSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dnsSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The settings always exist, adopt them as they are unless the
+	// configuration says otherwise.
+	if data.DisabledManagementGroups.IsUnknown() {
+		current, diags := r.getDnsSettings(ctx)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		settings, diags := toDnsSettingsModel(ctx, current)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+		return
+	}
+
+	res, err := r.client.PutApiDnsSettingsWithResponse(ctx, toDnsSettingsApiRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke update DNS settings API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	settings, diags := toDnsSettingsModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+}
+
+func (r *dnsSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dnsSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := r.getDnsSettings(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	settings, diags := toDnsSettingsModel(ctx, current)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+}
+
+func (r *dnsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PutApiDnsSettingsWithResponse(ctx, toDnsSettingsApiRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke update DNS settings API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	settings, diags := toDnsSettingsModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+}
+
+func (r *dnsSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings can't be removed from the account, restore the defaults instead.
+	res, err := r.client.PutApiDnsSettingsWithResponse(ctx, sdk.DNSSettings{
+		DisabledManagementGroups: []string{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke update DNS settings API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+}
+
+// ImportState adopts the account DNS settings whatever the import ID is, there
+// is only one instance of them.
+func (r *dnsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), dnsSettingsId)...)
+}
+
+// getDnsSettings fetches the current DNS settings. The OpenAPI spec describes
+// the response as an array, so the generated client can't decode it and the
+// body is unmarshalled here instead.
+func (r *dnsSettingsResource) getDnsSettings(ctx context.Context) (*sdk.DNSSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.GetApiDnsSettingsWithResponse(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke get DNS settings API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	var settings sdk.DNSSettings
+	if err := json.Unmarshal(res.Body, &settings); err != nil {
+		diags.AddError("failure to decode DNS settings", err.Error())
+		return nil, diags
+	}
+
+	return &settings, diags
+}
+
+func toDnsSettingsApiRequest(data dnsSettingsResourceModel) sdk.DNSSettings {
+	return sdk.DNSSettings{
+		DisabledManagementGroups: toStringSlice(data.DisabledManagementGroups),
+	}
+}
+
+func toDnsSettingsModel(ctx context.Context, data *sdk.DNSSettings) (dnsSettingsResourceModel, diag.Diagnostics) {
+	model := dnsSettingsResourceModel{
+		Id: types.StringValue(dnsSettingsId),
+	}
+
+	groups := data.DisabledManagementGroups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	var diags diag.Diagnostics
+	model.DisabledManagementGroups, diags = types.ListValueFrom(ctx, types.StringType, groups)
+
+	return model, diags
+}
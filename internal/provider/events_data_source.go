@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*eventsDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*eventsDataSource)(nil)
+
+func NewEventsDataSource() datasource.DataSource {
+	return &eventsDataSource{}
+}
+
+type eventsDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type eventsDataSourceModel struct {
+	ActivityCodes  types.List             `tfsdk:"activity_codes"`
+	Events         []eventDataSourceModel `tfsdk:"events"`
+	Ids            types.List             `tfsdk:"ids"`
+	InitiatorEmail types.String           `tfsdk:"initiator_email"`
+	MaxAge         types.String           `tfsdk:"max_age"`
+	Since          types.String           `tfsdk:"since"`
+	TargetId       types.String           `tfsdk:"target_id"`
+	Until          types.String           `tfsdk:"until"`
+}
+
+type eventDataSourceModel struct {
+	Activity       types.String `tfsdk:"activity"`
+	ActivityCode   types.String `tfsdk:"activity_code"`
+	Id             types.String `tfsdk:"id"`
+	InitiatorEmail types.String `tfsdk:"initiator_email"`
+	InitiatorId    types.String `tfsdk:"initiator_id"`
+	InitiatorName  types.String `tfsdk:"initiator_name"`
+	Meta           types.Map    `tfsdk:"meta"`
+	TargetId       types.String `tfsdk:"target_id"`
+	Timestamp      types.String `tfsdk:"timestamp"`
+}
+
+func (d *eventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+func (d *eventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists activity log events, optionally filtered. All filters that are set must match for an event to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"activity_codes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Only return events with one of these activity codes, e.g. peer.user.delete",
+				MarkdownDescription: "Only return events with one of these activity codes, e.g. `peer.user.delete`",
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Events matching the filters",
+				MarkdownDescription: "Events matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"activity": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The activity that occurred during the event",
+							MarkdownDescription: "The activity that occurred during the event",
+						},
+						"activity_code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The string code of the activity that occurred during the event",
+							MarkdownDescription: "The string code of the activity that occurred during the event",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Event unique identifier",
+							MarkdownDescription: "Event unique identifier",
+						},
+						"initiator_email": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The e-mail address of the initiator of the event",
+							MarkdownDescription: "The e-mail address of the initiator of the event",
+						},
+						"initiator_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the initiator of the event",
+							MarkdownDescription: "The ID of the initiator of the event",
+						},
+						"initiator_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the initiator of the event",
+							MarkdownDescription: "The name of the initiator of the event",
+						},
+						"meta": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The metadata of the event",
+							MarkdownDescription: "The metadata of the event",
+						},
+						"target_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the target of the event",
+							MarkdownDescription: "The ID of the target of the event",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The date and time when the event occurred, in RFC 3339 format",
+							MarkdownDescription: "The date and time when the event occurred, in RFC 3339 format",
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the events matching the filters",
+				MarkdownDescription: "IDs of the events matching the filters",
+			},
+			"initiator_email": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return events initiated by this e-mail address, matched ignoring case",
+				MarkdownDescription: "Only return events initiated by this e-mail address, matched ignoring case",
+			},
+			"max_age": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return events that occurred within this duration before now, e.g. 24h",
+				MarkdownDescription: "Only return events that occurred within this duration before now, e.g. `24h`",
+			},
+			"since": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return events that occurred at or after this RFC 3339 timestamp",
+				MarkdownDescription: "Only return events that occurred at or after this RFC 3339 timestamp",
+			},
+			"target_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return events targeting this ID",
+				MarkdownDescription: "Only return events targeting this ID",
+			},
+			"until": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return events that occurred before this RFC 3339 timestamp",
+				MarkdownDescription: "Only return events that occurred before this RFC 3339 timestamp",
+			},
+		},
+	}
+}
+
+func (d *eventsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("max_age"),
+			path.MatchRoot("since"),
+		),
+	}
+}
+
+func (d *eventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *eventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data eventsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var since, until time.Time
+	if !data.Since.IsNull() {
+		var err error
+		since, err = time.Parse(time.RFC3339, data.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid since", err.Error())
+			return
+		}
+	}
+	if !data.MaxAge.IsNull() {
+		maxAge, err := time.ParseDuration(data.MaxAge.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid max_age", err.Error())
+			return
+		}
+		since = time.Now().Add(-maxAge)
+	}
+	if !data.Until.IsNull() {
+		var err error
+		until, err = time.Parse(time.RFC3339, data.Until.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid until", err.Error())
+			return
+		}
+	}
+
+	var activityCodes []string
+	if !data.ActivityCodes.IsNull() {
+		activityCodes = toStringSlice(data.ActivityCodes)
+	}
+
+	res, err := d.client.GetApiEventsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list events API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	data.Events = []eventDataSourceModel{}
+	eventIds := []string{}
+	if res.JSON200 != nil {
+		for _, event := range *res.JSON200 {
+			if !since.IsZero() && event.Timestamp.Before(since) {
+				continue
+			}
+			if !until.IsZero() && !event.Timestamp.Before(until) {
+				continue
+			}
+			if activityCodes != nil && !slices.Contains(activityCodes, string(event.ActivityCode)) {
+				continue
+			}
+			if !data.InitiatorEmail.IsNull() && !strings.EqualFold(event.InitiatorEmail, data.InitiatorEmail.ValueString()) {
+				continue
+			}
+			if !data.TargetId.IsNull() && event.TargetId != data.TargetId.ValueString() {
+				continue
+			}
+
+			model, diags := toEventDataSourceModel(ctx, &event)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			data.Events = append(data.Events, model)
+			eventIds = append(eventIds, event.Id)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, eventIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toEventDataSourceModel(ctx context.Context, data *sdk.Event) (eventDataSourceModel, diag.Diagnostics) {
+	model := eventDataSourceModel{
+		Activity:       types.StringValue(data.Activity),
+		ActivityCode:   types.StringValue(string(data.ActivityCode)),
+		Id:             types.StringValue(data.Id),
+		InitiatorEmail: types.StringValue(data.InitiatorEmail),
+		InitiatorId:    types.StringValue(data.InitiatorId),
+		InitiatorName:  types.StringValue(data.InitiatorName),
+		TargetId:       types.StringValue(data.TargetId),
+		Timestamp:      types.StringValue(data.Timestamp.Format(time.RFC3339)),
+	}
+
+	meta := data.Meta
+	if meta == nil {
+		meta = map[string]string{}
+	}
+
+	var diags diag.Diagnostics
+	model.Meta, diags = types.MapValueFrom(ctx, types.StringType, meta)
+
+	return model, diags
+}
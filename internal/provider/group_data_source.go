@@ -2,22 +2,36 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
 )
 
 var _ datasource.DataSource = (*groupDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*groupDataSource)(nil)
 
 func NewGroupDataSource() datasource.DataSource {
 	return &groupDataSource{}
 }
 
-type groupDataSource struct{}
+type groupDataSource struct {
+	client *sdk.ClientWithResponses
+}
 
 type groupDataSourceModel struct {
-	Id types.String `tfsdk:"id"`
+	Id         types.String `tfsdk:"id"`
+	Issued     types.String `tfsdk:"issued"`
+	Name       types.String `tfsdk:"name"`
+	Peers      types.List   `tfsdk:"peers"`
+	PeersCount types.Int64  `tfsdk:"peers_count"`
 }
 
 func (d *groupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -26,14 +40,65 @@ func (d *groupDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 
 func (d *groupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Looks up a group by ID or by name. Exactly one of `id` or `name` must be set.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Computed: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The unique identifier of a group",
+				MarkdownDescription: "The unique identifier of a group",
+			},
+			"issued": schema.StringAttribute{
+				Computed:            true,
+				Description:         "How the group was issued (api, integration, jwt)",
+				MarkdownDescription: "How the group was issued (api, integration, jwt)",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Group name identifier",
+				MarkdownDescription: "Group name identifier",
+			},
+			"peers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "List of peers ids",
+				MarkdownDescription: "List of peers ids",
+			},
+			"peers_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Count of peers associated to the group",
+				MarkdownDescription: "Count of peers associated to the group",
 			},
 		},
 	}
 }
 
+func (d *groupDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *groupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
 func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data groupDataSourceModel
 
@@ -44,11 +109,99 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Read API call logic
+	var group *sdk.Group
+	if !data.Id.IsNull() {
+		res, err := d.client.GetApiGroupsGroupIdWithResponse(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failure to invoke get groups API", err.Error())
+			return
+		}
+
+		if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(err))
+			return
+		}
+		group = res.JSON200
+	} else {
+		var diags diag.Diagnostics
+		group, diags = findGroupByName(ctx, d.client, data.Name.ValueString())
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
 
-	// Example data value setting
-	data.Id = types.StringValue("example-id")
+	data, diags := toGroupDataSourceModel(ctx, group)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// findGroupByName returns the only group with the given name, failing when
+// no group or more than one group matches.
+func findGroupByName(ctx context.Context, client *sdk.ClientWithResponses, name string) (*sdk.Group, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.GetApiGroupsWithResponse(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke list groups API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	var matches []sdk.Group
+	if res.JSON200 != nil {
+		for _, group := range *res.JSON200 {
+			if group.Name == name {
+				matches = append(matches, group)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("group not found", fmt.Sprintf("No group named %q exists.", name))
+		return nil, diags
+	case 1:
+		return &matches[0], diags
+	default:
+		ids := make([]string, len(matches))
+		for i, group := range matches {
+			ids[i] = group.Id
+		}
+		diags.AddError("multiple groups found", fmt.Sprintf("%d groups are named %q (IDs: %v), look the group up by id instead.", len(matches), name, ids))
+		return nil, diags
+	}
+}
+
+func toGroupDataSourceModel(ctx context.Context, data *sdk.Group) (groupDataSourceModel, diag.Diagnostics) {
+	issued := ""
+	if data.Issued != nil {
+		issued = string(*data.Issued)
+	}
+
+	model := groupDataSourceModel{
+		Id:         types.StringValue(data.Id),
+		Issued:     types.StringValue(issued),
+		Name:       types.StringValue(data.Name),
+		PeersCount: types.Int64Value(int64(data.PeersCount)),
+	}
+
+	peers := make([]string, len(data.Peers))
+	for i, v := range data.Peers {
+		peers[i] = v.Id
+	}
+
+	var diags diag.Diagnostics
+	model.Peers, diags = types.ListValueFrom(ctx, types.StringType, peers)
+
+	return model, diags
+}
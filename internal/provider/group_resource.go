@@ -5,13 +5,16 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
 	"github.com/netbirdio/terraform-provider-netbird/internal/provider/resource_group"
 	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
 )
 
 var _ resource.Resource = (*groupResource)(nil)
+var _ resource.ResourceWithImportState = (*groupResource)(nil)
 
 func NewGroupResource() resource.Resource {
 	return &groupResource{}
@@ -60,6 +63,12 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("failure to invoke create groups API", err.Error())
 		return
 	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
 	createGroup, diags := toGroupModel(ctx, res.JSON200)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -83,8 +92,12 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 
@@ -116,8 +129,8 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, state.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 
@@ -140,18 +153,48 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	res, err := r.client.DeleteApiGroupsGroupId(ctx, data.Id.ValueString())
+	res, err := r.client.DeleteApiGroupsGroupIdWithResponse(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failure to invoke delete group API", err.Error())
 		return
 	}
 
-	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode), "")
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 }
 
+// ImportState accepts a group ID or the name of a group.
+func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	res, err := r.client.GetApiGroupsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list groups API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	var groups []sdk.Group
+	if res.JSON200 != nil {
+		groups = *res.JSON200
+	}
+
+	id, diags := resolveImportId("group", groups, req.ID,
+		func(group sdk.Group) string { return group.Id },
+		func(group sdk.Group) string { return group.Name },
+	)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
 func toGroupApiRequest(data resource_group.GroupModel) sdk.GroupRequest {
 	peers := make([]string, len(data.Peers.Elements()))
 	for i, v := range data.Peers.Elements() {
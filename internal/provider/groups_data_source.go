@@ -2,10 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
 )
 
 var _ datasource.DataSource = (*groupsDataSource)(nil)
@@ -14,10 +23,17 @@ func NewGroupsDataSource() datasource.DataSource {
 	return &groupsDataSource{}
 }
 
-type groupsDataSource struct{}
+type groupsDataSource struct {
+	client *sdk.ClientWithResponses
+}
 
 type groupsDataSourceModel struct {
-	Id types.String `tfsdk:"id"`
+	Groups        []groupDataSourceModel `tfsdk:"groups"`
+	Ids           types.List             `tfsdk:"ids"`
+	Issued        types.String           `tfsdk:"issued"`
+	MinPeersCount types.Int64            `tfsdk:"min_peers_count"`
+	Name          types.String           `tfsdk:"name"`
+	NameRegex     types.String           `tfsdk:"name_regex"`
 }
 
 func (d *groupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -26,14 +42,99 @@ func (d *groupsDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *groupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Lists groups, optionally filtered. All filters that are set must match for a group to be returned.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed: true,
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Groups matching the filters",
+				MarkdownDescription: "Groups matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The unique identifier of a group",
+							MarkdownDescription: "The unique identifier of a group",
+						},
+						"issued": schema.StringAttribute{
+							Computed:            true,
+							Description:         "How the group was issued (api, integration, jwt)",
+							MarkdownDescription: "How the group was issued (api, integration, jwt)",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Group name identifier",
+							MarkdownDescription: "Group name identifier",
+						},
+						"peers": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "List of peers ids",
+							MarkdownDescription: "List of peers ids",
+						},
+						"peers_count": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Count of peers associated to the group",
+							MarkdownDescription: "Count of peers associated to the group",
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the groups matching the filters",
+				MarkdownDescription: "IDs of the groups matching the filters",
+			},
+			"issued": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return groups issued this way (api, integration, jwt)",
+				MarkdownDescription: "Only return groups issued this way (`api`, `integration`, `jwt`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(sdk.GroupIssuedApi),
+						string(sdk.GroupIssuedIntegration),
+						string(sdk.GroupIssuedJwt),
+					),
+				},
+			},
+			"min_peers_count": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Only return groups with at least this many peers",
+				MarkdownDescription: "Only return groups with at least this many peers",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return groups with exactly this name",
+				MarkdownDescription: "Only return groups with exactly this name",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return groups whose name matches this regular expression",
+				MarkdownDescription: "Only return groups whose name matches this regular expression",
 			},
 		},
 	}
 }
 
+func (d *groupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
 func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data groupsDataSourceModel
 
@@ -44,10 +145,60 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Read API call logic
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid name_regex", err.Error())
+			return
+		}
+	}
 
-	// Example data value setting
-	data.Id = types.StringValue("example-id")
+	res, err := d.client.GetApiGroupsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list groups API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	data.Groups = []groupDataSourceModel{}
+	groupIds := []string{}
+	if res.JSON200 != nil {
+		for _, group := range *res.JSON200 {
+			if !data.Name.IsNull() && group.Name != data.Name.ValueString() {
+				continue
+			}
+			if nameRegex != nil && !nameRegex.MatchString(group.Name) {
+				continue
+			}
+			if !data.Issued.IsNull() && (group.Issued == nil || string(*group.Issued) != data.Issued.ValueString()) {
+				continue
+			}
+			if !data.MinPeersCount.IsNull() && int64(group.PeersCount) < data.MinPeersCount.ValueInt64() {
+				continue
+			}
+
+			model, diags := toGroupDataSourceModel(ctx, &group)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			data.Groups = append(data.Groups, model)
+			groupIds = append(groupIds, group.Id)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, groupIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
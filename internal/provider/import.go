@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// resolveImportId maps an import identifier to the ID of an object. The
+// identifier is used as is when it is the ID of one of items, otherwise it
+// must be the name of exactly one of them.
+func resolveImportId[T any](kind string, items []T, importId string, id func(T) string, name func(T) string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var matches []string
+	for _, item := range items {
+		if id(item) == importId {
+			return importId, diags
+		}
+		if name(item) == importId {
+			matches = append(matches, id(item))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(fmt.Sprintf("%s not found", kind), fmt.Sprintf("No %s has the ID or name %q.", kind, importId))
+		return "", diags
+	case 1:
+		return matches[0], diags
+	default:
+		diags.AddError(fmt.Sprintf("multiple %ss found", kind), fmt.Sprintf("%d %ss are named %q (IDs: %v), import the %s by id instead.", len(matches), kind, importId, matches, kind))
+		return "", diags
+	}
+}
@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*nameserverGroupResource)(nil)
+var _ resource.ResourceWithImportState = (*nameserverGroupResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*nameserverGroupResource)(nil)
+
+func NewNameserverGroupResource() resource.Resource {
+	return &nameserverGroupResource{}
+}
+
+type nameserverGroupResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type nameserverGroupResourceModel struct {
+	Description          types.String      `tfsdk:"description"`
+	Domains              types.List        `tfsdk:"domains"`
+	Enabled              types.Bool        `tfsdk:"enabled"`
+	Groups               types.List        `tfsdk:"groups"`
+	Id                   types.String      `tfsdk:"id"`
+	Name                 types.String      `tfsdk:"name"`
+	Nameservers          []nameserverModel `tfsdk:"nameservers"`
+	Primary              types.Bool        `tfsdk:"primary"`
+	SearchDomainsEnabled types.Bool        `tfsdk:"search_domains_enabled"`
+}
+
+type nameserverModel struct {
+	Ip     types.String `tfsdk:"ip"`
+	NsType types.String `tfsdk:"ns_type"`
+	Port   types.Int64  `tfsdk:"port"`
+}
+
+func (r *nameserverGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nameserver_group"
+}
+
+func (r *nameserverGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Description of the nameserver group",
+				MarkdownDescription: "Description of the nameserver group",
+			},
+			"domains": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description:         "Match domain list. It should be empty only if primary is true.",
+				MarkdownDescription: "Match domain list. It should be empty only if `primary` is true.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 255)),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				Description:         "Nameserver group status",
+				MarkdownDescription: "Nameserver group status",
+			},
+			"groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "Distribution group IDs that defines group of peers that will use this nameserver group",
+				MarkdownDescription: "Distribution group IDs that defines group of peers that will use this nameserver group",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Nameserver group ID",
+				MarkdownDescription: "Nameserver group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of nameserver group name",
+				MarkdownDescription: "Name of nameserver group name",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+				},
+			},
+			"nameservers": schema.ListNestedAttribute{
+				Required:            true,
+				Description:         "Nameserver list",
+				MarkdownDescription: "Nameserver list",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							Required:            true,
+							Description:         "Nameserver IP",
+							MarkdownDescription: "Nameserver IP",
+						},
+						"ns_type": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(sdk.NameserverNsTypeUdp)),
+							Description:         "Nameserver Type",
+							MarkdownDescription: "Nameserver Type",
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(sdk.NameserverNsTypeUdp)),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(53),
+							Description:         "Nameserver Port",
+							MarkdownDescription: "Nameserver Port",
+						},
+					},
+				},
+			},
+			"primary": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Defines if a nameserver group is primary that resolves all domains. It should be true only if domains list is empty.",
+				MarkdownDescription: "Defines if a nameserver group is primary that resolves all domains. It should be true only if `domains` list is empty.",
+			},
+			"search_domains_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Search domain status for match domains. It should be true only if domains list is not empty.",
+				MarkdownDescription: "Search domain status for match domains. It should be true only if `domains` list is not empty.",
+			},
+		},
+	}
+}
+
+func (r *nameserverGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var domains types.List
+	var primary types.Bool
+	var searchDomainsEnabled types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domains"), &domains)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("primary"), &primary)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("search_domains_enabled"), &searchDomainsEnabled)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The invariants can only be checked once the domain list is known.
+	if domains.IsUnknown() {
+		return
+	}
+	hasDomains := len(domains.Elements()) > 0
+
+	if !primary.IsUnknown() {
+		isPrimary := primary.ValueBool()
+		if isPrimary && hasDomains {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("primary"),
+				"Invalid Attribute Combination",
+				"primary can only be true when domains is empty, a primary nameserver group resolves all domains.",
+			)
+		}
+		if !isPrimary && !hasDomains {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("domains"),
+				"Invalid Attribute Combination",
+				"domains must not be empty unless primary is true.",
+			)
+		}
+	}
+
+	if !searchDomainsEnabled.IsUnknown() && searchDomainsEnabled.ValueBool() && !hasDomains {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("search_domains_enabled"),
+			"Invalid Attribute Combination",
+			"search_domains_enabled can only be true when domains is not empty.",
+		)
+	}
+}
+
+func (r *nameserverGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *nameserverGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data nameserverGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostApiDnsNameserversWithResponse(ctx, toNameserverGroupApiRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke create nameserver group API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	nameserverGroup, diags := toNameserverGroupModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &nameserverGroup)...)
+}
+
+func (r *nameserverGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data nameserverGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetApiDnsNameserversNsgroupIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke get nameserver group API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	nameserverGroup, diags := toNameserverGroupModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &nameserverGroup)...)
+}
+
+func (r *nameserverGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state nameserverGroupResourceModel
+	var plan nameserverGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PutApiDnsNameserversNsgroupIdWithResponse(ctx, state.Id.ValueString(), toNameserverGroupApiRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke update nameserver group API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, state.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	nameserverGroup, diags := toNameserverGroupModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &nameserverGroup)...)
+}
+
+func (r *nameserverGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data nameserverGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteApiDnsNameserversNsgroupIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke delete nameserver group API", err.Error())
+		return
+	}
+
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+}
+
+func (r *nameserverGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func toNameserverGroupApiRequest(data nameserverGroupResourceModel) sdk.NameserverGroupRequest {
+	nameservers := make([]sdk.Nameserver, len(data.Nameservers))
+	for i, v := range data.Nameservers {
+		nameservers[i] = sdk.Nameserver{
+			Ip:     v.Ip.ValueString(),
+			NsType: sdk.NameserverNsType(v.NsType.ValueString()),
+			Port:   int(v.Port.ValueInt64()),
+		}
+	}
+
+	description := ""
+	if !data.Description.IsUnknown() && !data.Description.IsNull() {
+		description = data.Description.ValueString()
+	}
+
+	return sdk.NameserverGroupRequest{
+		Description:          description,
+		Domains:              toStringSlice(data.Domains),
+		Enabled:              data.Enabled.ValueBool(),
+		Groups:               toStringSlice(data.Groups),
+		Name:                 data.Name.ValueString(),
+		Nameservers:          nameservers,
+		Primary:              data.Primary.ValueBool(),
+		SearchDomainsEnabled: data.SearchDomainsEnabled.ValueBool(),
+	}
+}
+
+func toNameserverGroupModel(ctx context.Context, data *sdk.NameserverGroup) (nameserverGroupResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	model := nameserverGroupResourceModel{
+		Description:          types.StringValue(data.Description),
+		Enabled:              types.BoolValue(data.Enabled),
+		Id:                   types.StringValue(data.Id),
+		Name:                 types.StringValue(data.Name),
+		Nameservers:          make([]nameserverModel, len(data.Nameservers)),
+		Primary:              types.BoolValue(data.Primary),
+		SearchDomainsEnabled: types.BoolValue(data.SearchDomainsEnabled),
+	}
+
+	for i, v := range data.Nameservers {
+		model.Nameservers[i] = nameserverModel{
+			Ip:     types.StringValue(v.Ip),
+			NsType: types.StringValue(string(v.NsType)),
+			Port:   types.Int64Value(int64(v.Port)),
+		}
+	}
+
+	domains := data.Domains
+	if domains == nil {
+		domains = []string{}
+	}
+	model.Domains, d = types.ListValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+
+	groups := data.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	model.Groups, d = types.ListValueFrom(ctx, types.StringType, groups)
+	diags.Append(d...)
+
+	return model, diags
+}
@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*peerDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*peerDataSource)(nil)
+
+func NewPeerDataSource() datasource.DataSource {
+	return &peerDataSource{}
+}
+
+type peerDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type peerDataSourceModel struct {
+	AccessiblePeersCount   types.Int64  `tfsdk:"accessible_peers_count"`
+	ApprovalRequired       types.Bool   `tfsdk:"approval_required"`
+	CityName               types.String `tfsdk:"city_name"`
+	Connected              types.Bool   `tfsdk:"connected"`
+	ConnectionIp           types.String `tfsdk:"connection_ip"`
+	CountryCode            types.String `tfsdk:"country_code"`
+	DnsLabel               types.String `tfsdk:"dns_label"`
+	GeonameId              types.Int64  `tfsdk:"geoname_id"`
+	GroupNames             types.List   `tfsdk:"group_names"`
+	Groups                 types.List   `tfsdk:"groups"`
+	Hostname               types.String `tfsdk:"hostname"`
+	Id                     types.String `tfsdk:"id"`
+	Ip                     types.String `tfsdk:"ip"`
+	KernelVersion          types.String `tfsdk:"kernel_version"`
+	LastLogin              types.String `tfsdk:"last_login"`
+	LastSeen               types.String `tfsdk:"last_seen"`
+	LoginExpirationEnabled types.Bool   `tfsdk:"login_expiration_enabled"`
+	LoginExpired           types.Bool   `tfsdk:"login_expired"`
+	Name                   types.String `tfsdk:"name"`
+	Os                     types.String `tfsdk:"os"`
+	SerialNumber           types.String `tfsdk:"serial_number"`
+	SshEnabled             types.Bool   `tfsdk:"ssh_enabled"`
+	UiVersion              types.String `tfsdk:"ui_version"`
+	UserId                 types.String `tfsdk:"user_id"`
+	Version                types.String `tfsdk:"version"`
+}
+
+func (d *peerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer"
+}
+
+func (d *peerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := peerDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Peer ID",
+		MarkdownDescription: "Peer ID",
+	}
+	attributes["hostname"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Hostname of the machine",
+		MarkdownDescription: "Hostname of the machine",
+	}
+	attributes["dns_label"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Peer's DNS label, used to form its FQDN",
+		MarkdownDescription: "Peer's DNS label, used to form its FQDN",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a peer by ID, hostname or DNS label. Exactly one of `id`, `hostname` or `dns_label` must be set.",
+		Attributes:  attributes,
+	}
+}
+
+// peerDataSourceAttributes returns the computed attributes describing a peer,
+// shared by the netbird_peer and netbird_peers data sources.
+func peerDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"accessible_peers_count": schema.Int64Attribute{
+			Computed:            true,
+			Description:         "Number of accessible peers",
+			MarkdownDescription: "Number of accessible peers",
+		},
+		"approval_required": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "(Cloud only) Indicates whether peer needs approval",
+			MarkdownDescription: "(Cloud only) Indicates whether peer needs approval",
+		},
+		"city_name": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Commonly used English name of the city",
+			MarkdownDescription: "Commonly used English name of the city",
+		},
+		"connected": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Peer to Management connection status",
+			MarkdownDescription: "Peer to Management connection status",
+		},
+		"connection_ip": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's public connection IP address",
+			MarkdownDescription: "Peer's public connection IP address",
+		},
+		"country_code": schema.StringAttribute{
+			Computed:            true,
+			Description:         "2-letter ISO 3166-1 alpha-2 code that represents the country",
+			MarkdownDescription: "2-letter ISO 3166-1 alpha-2 code that represents the country",
+		},
+		"dns_label": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's DNS label, used to form its FQDN",
+			MarkdownDescription: "Peer's DNS label, used to form its FQDN",
+		},
+		"geoname_id": schema.Int64Attribute{
+			Computed:            true,
+			Description:         "Unique identifier from the GeoNames database for a specific geographical location",
+			MarkdownDescription: "Unique identifier from the GeoNames database for a specific geographical location",
+		},
+		"group_names": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "Names of the groups that the peer belongs to",
+			MarkdownDescription: "Names of the groups that the peer belongs to",
+		},
+		"groups": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "IDs of the groups that the peer belongs to",
+			MarkdownDescription: "IDs of the groups that the peer belongs to",
+		},
+		"hostname": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Hostname of the machine",
+			MarkdownDescription: "Hostname of the machine",
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer ID",
+			MarkdownDescription: "Peer ID",
+		},
+		"ip": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's IP address",
+			MarkdownDescription: "Peer's IP address",
+		},
+		"kernel_version": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's operating system kernel version",
+			MarkdownDescription: "Peer's operating system kernel version",
+		},
+		"last_login": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Last time this peer performed log in (authentication)",
+			MarkdownDescription: "Last time this peer performed log in (authentication)",
+		},
+		"last_seen": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Last time peer connected to Netbird's management service",
+			MarkdownDescription: "Last time peer connected to Netbird's management service",
+		},
+		"login_expiration_enabled": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Indicates whether peer login expiration has been enabled or not",
+			MarkdownDescription: "Indicates whether peer login expiration has been enabled or not",
+		},
+		"login_expired": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Indicates whether peer's login expired or not",
+			MarkdownDescription: "Indicates whether peer's login expired or not",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's name",
+			MarkdownDescription: "Peer's name",
+		},
+		"os": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's operating system and version",
+			MarkdownDescription: "Peer's operating system and version",
+		},
+		"serial_number": schema.StringAttribute{
+			Computed:            true,
+			Description:         "System serial number",
+			MarkdownDescription: "System serial number",
+		},
+		"ssh_enabled": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Indicates whether SSH server is enabled on this peer",
+			MarkdownDescription: "Indicates whether SSH server is enabled on this peer",
+		},
+		"ui_version": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's desktop UI version",
+			MarkdownDescription: "Peer's desktop UI version",
+		},
+		"user_id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "User ID of the user that enrolled this peer",
+			MarkdownDescription: "User ID of the user that enrolled this peer",
+		},
+		"version": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer's daemon or cli version",
+			MarkdownDescription: "Peer's daemon or cli version",
+		},
+	}
+}
+
+func (d *peerDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("hostname"),
+			path.MatchRoot("dns_label"),
+		),
+	}
+}
+
+func (d *peerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *peerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data peerDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetApiPeersWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list peers API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	// The peer list carries more details than a single peer, so every
+	// lookup goes through it.
+	var field, value string
+	var match func(peer sdk.PeerBatch) bool
+	switch {
+	case !data.Id.IsNull():
+		field, value = "id", data.Id.ValueString()
+		match = func(peer sdk.PeerBatch) bool { return peer.Id == value }
+	case !data.Hostname.IsNull():
+		field, value = "hostname", data.Hostname.ValueString()
+		match = func(peer sdk.PeerBatch) bool { return peer.Hostname == value }
+	default:
+		field, value = "dns_label", data.DnsLabel.ValueString()
+		match = func(peer sdk.PeerBatch) bool { return peer.DnsLabel == value }
+	}
+
+	var matches []sdk.PeerBatch
+	if res.JSON200 != nil {
+		for _, peer := range *res.JSON200 {
+			if match(peer) {
+				matches = append(matches, peer)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("peer not found", fmt.Sprintf("No peer with %s %q exists.", field, value))
+		return
+	case 1:
+	default:
+		ids := make([]string, len(matches))
+		for i, peer := range matches {
+			ids[i] = peer.Id
+		}
+		resp.Diagnostics.AddError("multiple peers found", fmt.Sprintf("%d peers have %s %q (IDs: %v), look the peer up by id instead.", len(matches), field, value, ids))
+		return
+	}
+
+	data, diags := toPeerDataSourceModel(ctx, &matches[0])
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toPeerDataSourceModel(ctx context.Context, data *sdk.PeerBatch) (peerDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	model := peerDataSourceModel{
+		AccessiblePeersCount:   types.Int64Value(int64(data.AccessiblePeersCount)),
+		ApprovalRequired:       types.BoolValue(data.ApprovalRequired),
+		CityName:               types.StringValue(data.CityName),
+		Connected:              types.BoolValue(data.Connected),
+		ConnectionIp:           types.StringValue(data.ConnectionIp),
+		CountryCode:            types.StringValue(data.CountryCode),
+		DnsLabel:               types.StringValue(data.DnsLabel),
+		GeonameId:              types.Int64Value(int64(data.GeonameId)),
+		Hostname:               types.StringValue(data.Hostname),
+		Id:                     types.StringValue(data.Id),
+		Ip:                     types.StringValue(data.Ip),
+		KernelVersion:          types.StringValue(data.KernelVersion),
+		LastLogin:              types.StringValue(data.LastLogin.Format(time.RFC3339)),
+		LastSeen:               types.StringValue(data.LastSeen.Format(time.RFC3339)),
+		LoginExpirationEnabled: types.BoolValue(data.LoginExpirationEnabled),
+		LoginExpired:           types.BoolValue(data.LoginExpired),
+		Name:                   types.StringValue(data.Name),
+		Os:                     types.StringValue(data.Os),
+		SerialNumber:           types.StringValue(data.SerialNumber),
+		SshEnabled:             types.BoolValue(data.SshEnabled),
+		UiVersion:              types.StringValue(data.UiVersion),
+		UserId:                 types.StringValue(data.UserId),
+		Version:                types.StringValue(data.Version),
+	}
+
+	groups := make([]string, len(data.Groups))
+	groupNames := make([]string, len(data.Groups))
+	for i, v := range data.Groups {
+		groups[i] = v.Id
+		groupNames[i] = v.Name
+	}
+	model.Groups, d = types.ListValueFrom(ctx, types.StringType, groups)
+	diags.Append(d...)
+	model.GroupNames, d = types.ListValueFrom(ctx, types.StringType, groupNames)
+	diags.Append(d...)
+
+	return model, diags
+}
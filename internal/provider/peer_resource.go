@@ -0,0 +1,498 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*peerResource)(nil)
+var _ resource.ResourceWithImportState = (*peerResource)(nil)
+
+func NewPeerResource() resource.Resource {
+	return &peerResource{}
+}
+
+type peerResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type peerResourceModel struct {
+	AccessiblePeers        types.List   `tfsdk:"accessible_peers"`
+	ApprovalRequired       types.Bool   `tfsdk:"approval_required"`
+	CityName               types.String `tfsdk:"city_name"`
+	Connected              types.Bool   `tfsdk:"connected"`
+	ConnectionIp           types.String `tfsdk:"connection_ip"`
+	CountryCode            types.String `tfsdk:"country_code"`
+	DeleteOnDestroy        types.Bool   `tfsdk:"delete_on_destroy"`
+	DnsLabel               types.String `tfsdk:"dns_label"`
+	GeonameId              types.Int64  `tfsdk:"geoname_id"`
+	Groups                 types.List   `tfsdk:"groups"`
+	Hostname               types.String `tfsdk:"hostname"`
+	Id                     types.String `tfsdk:"id"`
+	Ip                     types.String `tfsdk:"ip"`
+	KernelVersion          types.String `tfsdk:"kernel_version"`
+	LastLogin              types.String `tfsdk:"last_login"`
+	LastSeen               types.String `tfsdk:"last_seen"`
+	LoginExpirationEnabled types.Bool   `tfsdk:"login_expiration_enabled"`
+	LoginExpired           types.Bool   `tfsdk:"login_expired"`
+	Name                   types.String `tfsdk:"name"`
+	Os                     types.String `tfsdk:"os"`
+	SerialNumber           types.String `tfsdk:"serial_number"`
+	SshEnabled             types.Bool   `tfsdk:"ssh_enabled"`
+	UiVersion              types.String `tfsdk:"ui_version"`
+	UserId                 types.String `tfsdk:"user_id"`
+	Version                types.String `tfsdk:"version"`
+}
+
+var accessiblePeerAttrTypes = map[string]attr.Type{
+	"dns_label": types.StringType,
+	"id":        types.StringType,
+	"ip":        types.StringType,
+	"name":      types.StringType,
+	"user_id":   types.StringType,
+}
+
+func (r *peerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer"
+}
+
+func (r *peerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an existing peer. Peers are created by clients enrolling with a setup key or SSO login, this resource adopts the peer with the given ID and manages its mutable settings.",
+		Attributes: map[string]schema.Attribute{
+			"accessible_peers": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of accessible peers",
+				MarkdownDescription: "List of accessible peers",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dns_label": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Peer's DNS label",
+							MarkdownDescription: "Peer's DNS label",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Peer ID",
+							MarkdownDescription: "Peer ID",
+						},
+						"ip": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Peer's IP address",
+							MarkdownDescription: "Peer's IP address",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Peer's hostname",
+							MarkdownDescription: "Peer's hostname",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "User ID of the user that enrolled this peer",
+							MarkdownDescription: "User ID of the user that enrolled this peer",
+						},
+					},
+				},
+			},
+			"approval_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "(Cloud only) Indicates whether peer needs approval",
+				MarkdownDescription: "(Cloud only) Indicates whether peer needs approval",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"city_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Commonly used English name of the city",
+				MarkdownDescription: "Commonly used English name of the city",
+			},
+			"connected": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Peer to Management connection status",
+				MarkdownDescription: "Peer to Management connection status",
+			},
+			"connection_ip": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Peer's public connection IP address",
+				MarkdownDescription: "Peer's public connection IP address",
+			},
+			"country_code": schema.StringAttribute{
+				Computed:            true,
+				Description:         "2-letter ISO 3166-1 alpha-2 code that represents the country",
+				MarkdownDescription: "2-letter ISO 3166-1 alpha-2 code that represents the country",
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Delete the peer from the account when the resource is destroyed. By default the peer is only removed from the Terraform state.",
+				MarkdownDescription: "Delete the peer from the account when the resource is destroyed. By default the peer is only removed from the Terraform state.",
+			},
+			"dns_label": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Peer's DNS label is the parsed peer name for domain resolution",
+				MarkdownDescription: "Peer's DNS label is the parsed peer name for domain resolution",
+			},
+			"geoname_id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Unique identifier from the GeoNames database for a specific geographical location.",
+				MarkdownDescription: "Unique identifier from the GeoNames database for a specific geographical location.",
+			},
+			"groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the groups that the peer belongs to",
+				MarkdownDescription: "IDs of the groups that the peer belongs to",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Hostname of the machine",
+				MarkdownDescription: "Hostname of the machine",
+			},
+			"id": schema.StringAttribute{
+				Required:            true,
+				Description:         "Peer ID of an existing peer",
+				MarkdownDescription: "Peer ID of an existing peer",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Peer's IP address",
+				MarkdownDescription: "Peer's IP address",
+			},
+			"kernel_version": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Peer's operating system kernel version",
+				MarkdownDescription: "Peer's operating system kernel version",
+			},
+			"last_login": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Last time this peer performed log in (authentication). E.g., user authenticated.",
+				MarkdownDescription: "Last time this peer performed log in (authentication). E.g., user authenticated.",
+			},
+			"last_seen": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Last time peer connected to Netbird's management service",
+				MarkdownDescription: "Last time peer connected to Netbird's management service",
+			},
+			"login_expiration_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Indicates whether peer login expiration has been enabled or not",
+				MarkdownDescription: "Indicates whether peer login expiration has been enabled or not",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"login_expired": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Indicates whether peer's login expired or not",
+				MarkdownDescription: "Indicates whether peer's login expired or not",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Peer's name",
+				MarkdownDescription: "Peer's name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"os": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Peer's operating system and version",
+				MarkdownDescription: "Peer's operating system and version",
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:            true,
+				Description:         "System serial number",
+				MarkdownDescription: "System serial number",
+			},
+			"ssh_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Indicates whether SSH server is enabled on this peer",
+				MarkdownDescription: "Indicates whether SSH server is enabled on this peer",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ui_version": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Peer's desktop UI version",
+				MarkdownDescription: "Peer's desktop UI version",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User ID of the user that enrolled this peer",
+				MarkdownDescription: "User ID of the user that enrolled this peer",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Peer's daemon or cli version",
+				MarkdownDescription: "Peer's daemon or cli version",
+			},
+		},
+	}
+}
+
+func (r *peerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *peerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config peerResourceModel
+	var plan peerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetApiPeersPeerIdWithResponse(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke get peer API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, plan.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	peer, diags := r.updatePeer(ctx, res.JSON200, config)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	peer.DeleteOnDestroy = plan.DeleteOnDestroy
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &peer)...)
+}
+
+func (r *peerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data peerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetApiPeersPeerIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke get peer API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	peer, diags := toPeerModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	peer.DeleteOnDestroy = data.DeleteOnDestroy
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &peer)...)
+}
+
+func (r *peerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config peerResourceModel
+	var plan peerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetApiPeersPeerIdWithResponse(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke get peer API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, plan.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	peer, diags := r.updatePeer(ctx, res.JSON200, config)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	peer.DeleteOnDestroy = plan.DeleteOnDestroy
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &peer)...)
+}
+
+func (r *peerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data peerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Peers are owned by the machines that enrolled them, only remove them
+	// when explicitly asked to.
+	if !data.DeleteOnDestroy.ValueBool() {
+		return
+	}
+
+	res, err := r.client.DeleteApiPeersPeerIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke delete peer API", err.Error())
+		return
+	}
+
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+}
+
+func (r *peerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Defaults aren't applied on import, keep imported peers on destroy.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_on_destroy"), false)...)
+}
+
+// updatePeer applies the attributes set in the configuration on top of the
+// current peer settings.
+func (r *peerResource) updatePeer(ctx context.Context, current *sdk.Peer, config peerResourceModel) (peerResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.PutApiPeersPeerIdWithResponse(ctx, current.Id, toPeerApiRequest(current, config))
+	if err != nil {
+		diags.AddError("failure to invoke update peer API", err.Error())
+		return peerResourceModel{}, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, current.Id); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return peerResourceModel{}, diags
+	}
+
+	return toPeerModel(ctx, res.JSON200)
+}
+
+func toPeerApiRequest(current *sdk.Peer, data peerResourceModel) sdk.PeerRequest {
+	request := sdk.PeerRequest{
+		LoginExpirationEnabled: current.LoginExpirationEnabled,
+		Name:                   current.Name,
+		SshEnabled:             current.SshEnabled,
+	}
+
+	if !data.ApprovalRequired.IsUnknown() && !data.ApprovalRequired.IsNull() {
+		request.ApprovalRequired = data.ApprovalRequired.ValueBoolPointer()
+	}
+
+	if !data.LoginExpirationEnabled.IsUnknown() && !data.LoginExpirationEnabled.IsNull() {
+		request.LoginExpirationEnabled = data.LoginExpirationEnabled.ValueBool()
+	}
+
+	if !data.Name.IsUnknown() && !data.Name.IsNull() {
+		request.Name = data.Name.ValueString()
+	}
+
+	if !data.SshEnabled.IsUnknown() && !data.SshEnabled.IsNull() {
+		request.SshEnabled = data.SshEnabled.ValueBool()
+	}
+
+	return request
+}
+
+func toPeerModel(ctx context.Context, data *sdk.Peer) (peerResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	model := peerResourceModel{
+		ApprovalRequired:       types.BoolValue(data.ApprovalRequired),
+		CityName:               types.StringValue(data.CityName),
+		Connected:              types.BoolValue(data.Connected),
+		ConnectionIp:           types.StringValue(data.ConnectionIp),
+		CountryCode:            types.StringValue(data.CountryCode),
+		DnsLabel:               types.StringValue(data.DnsLabel),
+		GeonameId:              types.Int64Value(int64(data.GeonameId)),
+		Hostname:               types.StringValue(data.Hostname),
+		Id:                     types.StringValue(data.Id),
+		Ip:                     types.StringValue(data.Ip),
+		KernelVersion:          types.StringValue(data.KernelVersion),
+		LastLogin:              types.StringValue(data.LastLogin.Format(time.RFC3339)),
+		LastSeen:               types.StringValue(data.LastSeen.Format(time.RFC3339)),
+		LoginExpirationEnabled: types.BoolValue(data.LoginExpirationEnabled),
+		LoginExpired:           types.BoolValue(data.LoginExpired),
+		Name:                   types.StringValue(data.Name),
+		Os:                     types.StringValue(data.Os),
+		SerialNumber:           types.StringValue(data.SerialNumber),
+		SshEnabled:             types.BoolValue(data.SshEnabled),
+		UiVersion:              types.StringValue(data.UiVersion),
+		UserId:                 types.StringValue(data.UserId),
+		Version:                types.StringValue(data.Version),
+	}
+
+	groups := make([]string, len(data.Groups))
+	for i, v := range data.Groups {
+		groups[i] = v.Id
+	}
+	model.Groups, d = types.ListValueFrom(ctx, types.StringType, groups)
+	diags.Append(d...)
+
+	accessiblePeers := make([]attr.Value, len(data.AccessiblePeers))
+	for i, v := range data.AccessiblePeers {
+		accessiblePeers[i], d = types.ObjectValue(accessiblePeerAttrTypes, map[string]attr.Value{
+			"dns_label": types.StringValue(v.DnsLabel),
+			"id":        types.StringValue(v.Id),
+			"ip":        types.StringValue(v.Ip),
+			"name":      types.StringValue(v.Name),
+			"user_id":   types.StringValue(v.UserId),
+		})
+		diags.Append(d...)
+	}
+	model.AccessiblePeers, d = types.ListValue(types.ObjectType{AttrTypes: accessiblePeerAttrTypes}, accessiblePeers)
+	diags.Append(d...)
+
+	return model, diags
+}
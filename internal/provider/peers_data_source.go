@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*peersDataSource)(nil)
+
+func NewPeersDataSource() datasource.DataSource {
+	return &peersDataSource{}
+}
+
+type peersDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type peersDataSourceModel struct {
+	Connected   types.Bool            `tfsdk:"connected"`
+	CountryCode types.String          `tfsdk:"country_code"`
+	GroupId     types.String          `tfsdk:"group_id"`
+	GroupName   types.String          `tfsdk:"group_name"`
+	Hostname    types.String          `tfsdk:"hostname"`
+	Ids         types.List            `tfsdk:"ids"`
+	Os          types.String          `tfsdk:"os"`
+	Peers       []peerDataSourceModel `tfsdk:"peers"`
+	Version     types.String          `tfsdk:"version"`
+}
+
+func (d *peersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peers"
+}
+
+func (d *peersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists peers, optionally filtered. All filters that are set must match for a peer to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"connected": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only return peers with this connection status",
+				MarkdownDescription: "Only return peers with this connection status",
+			},
+			"country_code": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return peers located in this country (2-letter ISO 3166-1 alpha-2 code)",
+				MarkdownDescription: "Only return peers located in this country (2-letter ISO 3166-1 alpha-2 code)",
+			},
+			"group_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return peers belonging to the group with this ID",
+				MarkdownDescription: "Only return peers belonging to the group with this ID",
+			},
+			"group_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return peers belonging to a group with this name",
+				MarkdownDescription: "Only return peers belonging to a group with this name",
+			},
+			"hostname": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return peers whose hostname matches this glob pattern, e.g. web-*",
+				MarkdownDescription: "Only return peers whose hostname matches this glob pattern, e.g. `web-*`",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the peers matching the filters",
+				MarkdownDescription: "IDs of the peers matching the filters",
+			},
+			"os": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return peers whose operating system contains this string, ignoring case",
+				MarkdownDescription: "Only return peers whose operating system contains this string, ignoring case",
+			},
+			"peers": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Peers matching the filters",
+				MarkdownDescription: "Peers matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: peerDataSourceAttributes(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return peers running this NetBird version",
+				MarkdownDescription: "Only return peers running this NetBird version",
+			},
+		},
+	}
+}
+
+func (d *peersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *peersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data peersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Hostname.IsNull() {
+		if _, err := path.Match(data.Hostname.ValueString(), ""); err != nil {
+			resp.Diagnostics.AddError("invalid hostname pattern", err.Error())
+			return
+		}
+	}
+
+	res, err := d.client.GetApiPeersWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list peers API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	data.Peers = []peerDataSourceModel{}
+	peerIds := []string{}
+	if res.JSON200 != nil {
+		for _, peer := range *res.JSON200 {
+			if !data.Connected.IsNull() && peer.Connected != data.Connected.ValueBool() {
+				continue
+			}
+			if !data.CountryCode.IsNull() && !strings.EqualFold(peer.CountryCode, data.CountryCode.ValueString()) {
+				continue
+			}
+			if !data.GroupId.IsNull() && !peerInGroup(peer, func(group sdk.GroupMinimum) bool { return group.Id == data.GroupId.ValueString() }) {
+				continue
+			}
+			if !data.GroupName.IsNull() && !peerInGroup(peer, func(group sdk.GroupMinimum) bool { return group.Name == data.GroupName.ValueString() }) {
+				continue
+			}
+			if !data.Hostname.IsNull() {
+				if matched, _ := path.Match(data.Hostname.ValueString(), peer.Hostname); !matched {
+					continue
+				}
+			}
+			if !data.Os.IsNull() && !strings.Contains(strings.ToLower(peer.Os), strings.ToLower(data.Os.ValueString())) {
+				continue
+			}
+			if !data.Version.IsNull() && peer.Version != data.Version.ValueString() {
+				continue
+			}
+
+			model, diags := toPeerDataSourceModel(ctx, &peer)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			data.Peers = append(data.Peers, model)
+			peerIds = append(peerIds, peer.Id)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, peerIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func peerInGroup(peer sdk.PeerBatch, match func(group sdk.GroupMinimum) bool) bool {
+	for _, group := range peer.Groups {
+		if match(group) {
+			return true
+		}
+	}
+	return false
+}
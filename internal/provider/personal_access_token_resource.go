@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*personalAccessTokenResource)(nil)
+var _ resource.ResourceWithImportState = (*personalAccessTokenResource)(nil)
+
+func NewPersonalAccessTokenResource() resource.Resource {
+	return &personalAccessTokenResource{}
+}
+
+type personalAccessTokenResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type personalAccessTokenResourceModel struct {
+	CreatedAt      types.String `tfsdk:"created_at"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	ExpiresIn      types.Int64  `tfsdk:"expires_in"`
+	Id             types.String `tfsdk:"id"`
+	LastUsed       types.String `tfsdk:"last_used"`
+	Name           types.String `tfsdk:"name"`
+	PlainToken     types.String `tfsdk:"plain_token"`
+	UserId         types.String `tfsdk:"user_id"`
+}
+
+func (r *personalAccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_access_token"
+}
+
+func (r *personalAccessTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Personal access token of a user. The plain token is only returned by the API when the token is created.",
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Date the token was created",
+				MarkdownDescription: "Date the token was created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User ID of the user who created the token",
+				MarkdownDescription: "User ID of the user who created the token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Date the token expires",
+				MarkdownDescription: "Date the token expires",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_in": schema.Int64Attribute{
+				Required:            true,
+				Description:         "Expiration in days",
+				MarkdownDescription: "Expiration in days",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 365),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of a token",
+				MarkdownDescription: "ID of a token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Date the token was last used",
+				MarkdownDescription: "Date the token was last used",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the token",
+				MarkdownDescription: "Name of the token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plain_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "Plain text representation of the generated token",
+				MarkdownDescription: "Plain text representation of the generated token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The unique identifier of the user owning the token",
+				MarkdownDescription: "The unique identifier of the user owning the token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *personalAccessTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *personalAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data personalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostApiUsersUserIdTokensWithResponse(ctx, data.UserId.ValueString(), sdk.PersonalAccessTokenRequest{
+		ExpiresIn: int(data.ExpiresIn.ValueInt64()),
+		Name:      data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke create personal access token API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	token := toPersonalAccessTokenModel(data.UserId.ValueString(), &res.JSON200.PersonalAccessToken)
+	token.ExpiresIn = data.ExpiresIn
+	token.PlainToken = types.StringValue(res.JSON200.PlainToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &token)...)
+}
+
+func (r *personalAccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data personalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetApiUsersUserIdTokensTokenIdWithResponse(ctx, data.UserId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke get personal access token API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	// The plain token and requested expiry are never returned after creation.
+	token := toPersonalAccessTokenModel(data.UserId.ValueString(), res.JSON200)
+	token.ExpiresIn = data.ExpiresIn
+	token.PlainToken = data.PlainToken
+
+	// Imported tokens have no expiry in days yet, derive it from the dates.
+	if data.ExpiresIn.IsNull() {
+		days := res.JSON200.ExpirationDate.Sub(res.JSON200.CreatedAt).Hours() / 24
+		token.ExpiresIn = types.Int64Value(int64(math.Round(days)))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &token)...)
+}
+
+func (r *personalAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan personalAccessTokenResourceModel
+
+	// Every configurable attribute requires replacement, there is nothing to
+	// send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *personalAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data personalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteApiUsersUserIdTokensTokenIdWithResponse(ctx, data.UserId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke delete personal access token API", err.Error())
+		return
+	}
+
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+}
+
+// ImportState expects an ID of the form <user_id>/<token_id>.
+func (r *personalAccessTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userId, tokenId, ok := strings.Cut(req.ID, "/")
+	if !ok || userId == "" || tokenId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form <user_id>/<token_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tokenId)...)
+}
+
+func toPersonalAccessTokenModel(userId string, data *sdk.PersonalAccessToken) personalAccessTokenResourceModel {
+	lastUsed := ""
+	if data.LastUsed != nil {
+		lastUsed = data.LastUsed.Format(time.RFC3339)
+	}
+
+	return personalAccessTokenResourceModel{
+		CreatedAt:      types.StringValue(data.CreatedAt.Format(time.RFC3339)),
+		CreatedBy:      types.StringValue(data.CreatedBy),
+		ExpirationDate: types.StringValue(data.ExpirationDate.Format(time.RFC3339)),
+		Id:             types.StringValue(data.Id),
+		LastUsed:       types.StringValue(lastUsed),
+		Name:           types.StringValue(data.Name),
+		UserId:         types.StringValue(userId),
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*policiesDataSource)(nil)
+
+func NewPoliciesDataSource() datasource.DataSource {
+	return &policiesDataSource{}
+}
+
+type policiesDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type policiesDataSourceModel struct {
+	Enabled  types.Bool            `tfsdk:"enabled"`
+	Group    types.String          `tfsdk:"group"`
+	Ids      types.List            `tfsdk:"ids"`
+	Name     types.String          `tfsdk:"name"`
+	Policies []policyResourceModel `tfsdk:"policies"`
+}
+
+func (d *policiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *policiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists access policies, optionally filtered. All filters that are set must match for a policy to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only return policies with this status",
+				MarkdownDescription: "Only return policies with this status",
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return policies with a rule using this group ID as a source or destination",
+				MarkdownDescription: "Only return policies with a rule using this group ID as a source or destination",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the policies matching the filters",
+				MarkdownDescription: "IDs of the policies matching the filters",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return policies with this name",
+				MarkdownDescription: "Only return policies with this name",
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Policies matching the filters",
+				MarkdownDescription: "Policies matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *policiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data policiesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, diags := listPolicies(ctx, d.client)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data.Policies = []policyResourceModel{}
+	policyIds := []string{}
+	for _, policy := range policies {
+		if !data.Enabled.IsNull() && policy.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+		if !data.Group.IsNull() && !policyReferencesGroup(policy, data.Group.ValueString()) {
+			continue
+		}
+		if !data.Name.IsNull() && policy.Name != data.Name.ValueString() {
+			continue
+		}
+
+		model, diags := toPolicyModel(ctx, &policy)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		data.Policies = append(data.Policies, model)
+		policyIds = append(policyIds, model.Id.ValueString())
+	}
+
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, policyIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
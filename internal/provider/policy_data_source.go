@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*policyDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*policyDataSource)(nil)
+
+func NewPolicyDataSource() datasource.DataSource {
+	return &policyDataSource{}
+}
+
+type policyDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (d *policyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (d *policyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := policyDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Policy ID",
+		MarkdownDescription: "Policy ID",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Policy name identifier",
+		MarkdownDescription: "Policy name identifier",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up an access policy by ID or by name. Exactly one of `id` or `name` must be set.",
+		Attributes:  attributes,
+	}
+}
+
+// policyDataSourceAttributes returns the computed attributes describing a
+// policy, shared by the netbird_policy and netbird_policies data sources.
+func policyDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"description": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Policy friendly description",
+			MarkdownDescription: "Policy friendly description",
+		},
+		"enabled": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Policy status",
+			MarkdownDescription: "Policy status",
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Policy ID",
+			MarkdownDescription: "Policy ID",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Policy name identifier",
+			MarkdownDescription: "Policy name identifier",
+		},
+		"rules": schema.ListNestedAttribute{
+			Computed:            true,
+			Description:         "Policy rule object for policy UI editor",
+			MarkdownDescription: "Policy rule object for policy UI editor",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"action": schema.StringAttribute{
+						Computed:            true,
+						Description:         "Policy rule accept or drops packets",
+						MarkdownDescription: "Policy rule accept or drops packets",
+					},
+					"bidirectional": schema.BoolAttribute{
+						Computed:            true,
+						Description:         "Define if the rule is applicable in both directions, sources, and destinations.",
+						MarkdownDescription: "Define if the rule is applicable in both directions, sources, and destinations.",
+					},
+					"description": schema.StringAttribute{
+						Computed:            true,
+						Description:         "Policy rule friendly description",
+						MarkdownDescription: "Policy rule friendly description",
+					},
+					"destinations": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Computed:            true,
+						Description:         "Policy rule destination group IDs",
+						MarkdownDescription: "Policy rule destination group IDs",
+					},
+					"enabled": schema.BoolAttribute{
+						Computed:            true,
+						Description:         "Policy rule status",
+						MarkdownDescription: "Policy rule status",
+					},
+					"id": schema.StringAttribute{
+						Computed:            true,
+						Description:         "Policy rule ID",
+						MarkdownDescription: "Policy rule ID",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						Description:         "Policy rule name identifier",
+						MarkdownDescription: "Policy rule name identifier",
+					},
+					"ports": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Computed:            true,
+						Description:         "Policy rule affected ports or it ranges list",
+						MarkdownDescription: "Policy rule affected ports or it ranges list",
+					},
+					"protocol": schema.StringAttribute{
+						Computed:            true,
+						Description:         "Policy rule type of the traffic",
+						MarkdownDescription: "Policy rule type of the traffic",
+					},
+					"sources": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Computed:            true,
+						Description:         "Policy rule source group IDs",
+						MarkdownDescription: "Policy rule source group IDs",
+					},
+				},
+			},
+		},
+		"source_posture_checks": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "Posture checks ID's applied to policy source groups",
+			MarkdownDescription: "Posture checks ID's applied to policy source groups",
+		},
+	}
+}
+
+func (d *policyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *policyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *policyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data policyResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy *sdk.Policy
+	if !data.Id.IsNull() {
+		res, err := d.client.GetApiPoliciesPolicyIdWithResponse(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failure to invoke get policy API", err.Error())
+			return
+		}
+
+		if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(err))
+			return
+		}
+		policy = res.JSON200
+	} else {
+		var diags diag.Diagnostics
+		policy, diags = findPolicyByName(ctx, d.client, data.Name.ValueString())
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	data, diags := toPolicyModel(ctx, policy)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listPolicies(ctx context.Context, client *sdk.ClientWithResponses) ([]sdk.Policy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.GetApiPoliciesWithResponse(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke list policies API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	if res.JSON200 == nil {
+		return []sdk.Policy{}, diags
+	}
+	return *res.JSON200, diags
+}
+
+// findPolicyByName returns the only policy with the given name, failing when
+// no policy or more than one policy matches.
+func findPolicyByName(ctx context.Context, client *sdk.ClientWithResponses, name string) (*sdk.Policy, diag.Diagnostics) {
+	policies, diags := listPolicies(ctx, client)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var matches []sdk.Policy
+	for _, policy := range policies {
+		if policy.Name == name {
+			matches = append(matches, policy)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("policy not found", fmt.Sprintf("No policy named %q exists.", name))
+		return nil, diags
+	case 1:
+		return &matches[0], diags
+	default:
+		ids := make([]string, len(matches))
+		for i, policy := range matches {
+			if policy.Id != nil {
+				ids[i] = *policy.Id
+			}
+		}
+		diags.AddError("multiple policies found", fmt.Sprintf("%d policies are named %q (IDs: %v), look the policy up by id instead.", len(matches), name, ids))
+		return nil, diags
+	}
+}
+
+// policyReferencesGroup reports whether a rule of the policy uses the group as
+// a source or a destination.
+func policyReferencesGroup(policy sdk.Policy, groupId string) bool {
+	for _, rule := range policy.Rules {
+		for _, group := range rule.Sources {
+			if group.Id == groupId {
+				return true
+			}
+		}
+		for _, group := range rule.Destinations {
+			if group.Id == groupId {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*policyResource)(nil)
+var _ resource.ResourceWithImportState = (*policyResource)(nil)
+
+func NewPolicyResource() resource.Resource {
+	return &policyResource{}
+}
+
+type policyResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type policyResourceModel struct {
+	Description         types.String      `tfsdk:"description"`
+	Enabled             types.Bool        `tfsdk:"enabled"`
+	Id                  types.String      `tfsdk:"id"`
+	Name                types.String      `tfsdk:"name"`
+	Rules               []policyRuleModel `tfsdk:"rules"`
+	SourcePostureChecks types.List        `tfsdk:"source_posture_checks"`
+}
+
+type policyRuleModel struct {
+	Action        types.String `tfsdk:"action"`
+	Bidirectional types.Bool   `tfsdk:"bidirectional"`
+	Description   types.String `tfsdk:"description"`
+	Destinations  types.List   `tfsdk:"destinations"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Ports         types.List   `tfsdk:"ports"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Sources       types.List   `tfsdk:"sources"`
+}
+
+func (r *policyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyList := types.ListValueMust(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Policy friendly description",
+				MarkdownDescription: "Policy friendly description",
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				Description:         "Policy status",
+				MarkdownDescription: "Policy status",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Policy ID",
+				MarkdownDescription: "Policy ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Policy name identifier",
+				MarkdownDescription: "Policy name identifier",
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:            true,
+				Description:         "Policy rule object for policy UI editor",
+				MarkdownDescription: "Policy rule object for policy UI editor",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Required:            true,
+							Description:         "Policy rule accept or drops packets",
+							MarkdownDescription: "Policy rule accept or drops packets",
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(sdk.Accept), string(sdk.Drop)),
+							},
+						},
+						"bidirectional": schema.BoolAttribute{
+							Required:            true,
+							Description:         "Define if the rule is applicable in both directions, sources, and destinations.",
+							MarkdownDescription: "Define if the rule is applicable in both directions, sources, and destinations.",
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+							Description:         "Policy rule friendly description",
+							MarkdownDescription: "Policy rule friendly description",
+						},
+						"destinations": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							Description:         "Policy rule destination group IDs",
+							MarkdownDescription: "Policy rule destination group IDs",
+						},
+						"enabled": schema.BoolAttribute{
+							Required:            true,
+							Description:         "Policy rule status",
+							MarkdownDescription: "Policy rule status",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Policy rule ID",
+							MarkdownDescription: "Policy rule ID",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							Description:         "Policy rule name identifier",
+							MarkdownDescription: "Policy rule name identifier",
+						},
+						"ports": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+							Default:             listdefault.StaticValue(emptyList),
+							Description:         "Policy rule affected ports or it ranges list",
+							MarkdownDescription: "Policy rule affected ports or it ranges list",
+						},
+						"protocol": schema.StringAttribute{
+							Required:            true,
+							Description:         "Policy rule type of the traffic",
+							MarkdownDescription: "Policy rule type of the traffic",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(sdk.PolicyRuleUpdateProtocolAll),
+									string(sdk.PolicyRuleUpdateProtocolIcmp),
+									string(sdk.PolicyRuleUpdateProtocolTcp),
+									string(sdk.PolicyRuleUpdateProtocolUdp),
+								),
+							},
+						},
+						"sources": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							Description:         "Policy rule source group IDs",
+							MarkdownDescription: "Policy rule source group IDs",
+						},
+					},
+				},
+			},
+			"source_posture_checks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(emptyList),
+				Description:         "Posture checks ID's applied to policy source groups",
+				MarkdownDescription: "Posture checks ID's applied to policy source groups",
+			},
+		},
+	}
+}
+
+func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data policyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostApiPoliciesWithResponse(ctx, toPolicyApiRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke create policy API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	policy, diags := toPolicyModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &policy)...)
+}
+
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data policyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetApiPoliciesPolicyIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke get policy API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	policy, diags := toPolicyModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &policy)...)
+}
+
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state policyResourceModel
+	var plan policyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PutApiPoliciesPolicyIdWithResponse(ctx, state.Id.ValueString(), toPolicyApiRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke update policy API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, state.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	policy, diags := toPolicyModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &policy)...)
+}
+
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data policyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteApiPoliciesPolicyIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke delete policy API", err.Error())
+		return
+	}
+
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+}
+
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func toPolicyApiRequest(data policyResourceModel) sdk.PolicyUpdate {
+	rules := make([]sdk.PolicyRuleUpdate, len(data.Rules))
+	for i, rule := range data.Rules {
+		var ruleId *string
+		if !rule.Id.IsUnknown() && !rule.Id.IsNull() {
+			ruleId = rule.Id.ValueStringPointer()
+		}
+
+		description := ""
+		if !rule.Description.IsUnknown() && !rule.Description.IsNull() {
+			description = rule.Description.ValueString()
+		}
+
+		ports := toStringSlice(rule.Ports)
+
+		rules[i] = sdk.PolicyRuleUpdate{
+			Action:        sdk.PolicyRuleUpdateAction(rule.Action.ValueString()),
+			Bidirectional: rule.Bidirectional.ValueBool(),
+			Description:   &description,
+			Destinations:  toStringSlice(rule.Destinations),
+			Enabled:       rule.Enabled.ValueBool(),
+			Id:            ruleId,
+			Name:          rule.Name.ValueString(),
+			Ports:         &ports,
+			Protocol:      sdk.PolicyRuleUpdateProtocol(rule.Protocol.ValueString()),
+			Sources:       toStringSlice(rule.Sources),
+		}
+	}
+
+	description := ""
+	if !data.Description.IsUnknown() && !data.Description.IsNull() {
+		description = data.Description.ValueString()
+	}
+
+	enabled := false
+	if !data.Enabled.IsUnknown() && !data.Enabled.IsNull() {
+		enabled = data.Enabled.ValueBool()
+	}
+
+	sourcePostureChecks := toStringSlice(data.SourcePostureChecks)
+
+	return sdk.PolicyUpdate{
+		Description:         description,
+		Enabled:             enabled,
+		Name:                data.Name.ValueString(),
+		Rules:               rules,
+		SourcePostureChecks: &sourcePostureChecks,
+	}
+}
+
+func toPolicyModel(ctx context.Context, data *sdk.Policy) (policyResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := policyResourceModel{
+		Description: types.StringValue(data.Description),
+		Enabled:     types.BoolValue(data.Enabled),
+		Id:          types.StringPointerValue(data.Id),
+		Name:        types.StringValue(data.Name),
+		Rules:       make([]policyRuleModel, len(data.Rules)),
+	}
+
+	for i, rule := range data.Rules {
+		var d diag.Diagnostics
+
+		description := ""
+		if rule.Description != nil {
+			description = *rule.Description
+		}
+
+		ports := []string{}
+		if rule.Ports != nil {
+			ports = *rule.Ports
+		}
+
+		sources := make([]string, len(rule.Sources))
+		for j, v := range rule.Sources {
+			sources[j] = v.Id
+		}
+		destinations := make([]string, len(rule.Destinations))
+		for j, v := range rule.Destinations {
+			destinations[j] = v.Id
+		}
+
+		ruleModel := policyRuleModel{
+			Action:        types.StringValue(string(rule.Action)),
+			Bidirectional: types.BoolValue(rule.Bidirectional),
+			Description:   types.StringValue(description),
+			Enabled:       types.BoolValue(rule.Enabled),
+			Id:            types.StringPointerValue(rule.Id),
+			Name:          types.StringValue(rule.Name),
+			Protocol:      types.StringValue(string(rule.Protocol)),
+		}
+		ruleModel.Ports, d = types.ListValueFrom(ctx, types.StringType, ports)
+		diags.Append(d...)
+		ruleModel.Sources, d = types.ListValueFrom(ctx, types.StringType, sources)
+		diags.Append(d...)
+		ruleModel.Destinations, d = types.ListValueFrom(ctx, types.StringType, destinations)
+		diags.Append(d...)
+
+		model.Rules[i] = ruleModel
+	}
+
+	sourcePostureChecks := data.SourcePostureChecks
+	if sourcePostureChecks == nil {
+		sourcePostureChecks = []string{}
+	}
+	var d diag.Diagnostics
+	model.SourcePostureChecks, d = types.ListValueFrom(ctx, types.StringType, sourcePostureChecks)
+	diags.Append(d...)
+
+	return model, diags
+}
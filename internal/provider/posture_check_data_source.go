@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*postureCheckDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*postureCheckDataSource)(nil)
+
+func NewPostureCheckDataSource() datasource.DataSource {
+	return &postureCheckDataSource{}
+}
+
+type postureCheckDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (d *postureCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_posture_check"
+}
+
+func (d *postureCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := postureCheckDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Posture check ID",
+		MarkdownDescription: "Posture check ID",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Posture check name identifier",
+		MarkdownDescription: "Posture check name identifier",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a posture check by ID or by name. Exactly one of `id` or `name` must be set.",
+		Attributes:  attributes,
+	}
+}
+
+// postureCheckDataSourceAttributes returns the computed attributes describing
+// a posture check, shared by the netbird_posture_check and
+// netbird_posture_checks data sources.
+func postureCheckDataSourceAttributes() map[string]schema.Attribute {
+	minVersionAttributes := map[string]schema.Attribute{
+		"min_version": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Minimum acceptable version",
+			MarkdownDescription: "Minimum acceptable version",
+		},
+	}
+	minKernelVersionAttributes := map[string]schema.Attribute{
+		"min_kernel_version": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Minimum acceptable version",
+			MarkdownDescription: "Minimum acceptable version",
+		},
+	}
+
+	return map[string]schema.Attribute{
+		"description": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Posture check friendly description",
+			MarkdownDescription: "Posture check friendly description",
+		},
+		"geo_location_check": schema.SingleNestedAttribute{
+			Computed:            true,
+			Description:         "Posture check for geo location",
+			MarkdownDescription: "Posture check for geo location",
+			Attributes: map[string]schema.Attribute{
+				"action": schema.StringAttribute{
+					Computed:            true,
+					Description:         "Action to take upon policy match",
+					MarkdownDescription: "Action to take upon policy match",
+				},
+				"locations": schema.ListNestedAttribute{
+					Computed:            true,
+					Description:         "List of geo locations to which the policy applies",
+					MarkdownDescription: "List of geo locations to which the policy applies",
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"city_name": schema.StringAttribute{
+								Computed:            true,
+								Description:         "Commonly used English name of the city",
+								MarkdownDescription: "Commonly used English name of the city",
+							},
+							"country_code": schema.StringAttribute{
+								Computed:            true,
+								Description:         "2-letter ISO 3166-1 alpha-2 code that represents the country",
+								MarkdownDescription: "2-letter ISO 3166-1 alpha-2 code that represents the country",
+							},
+						},
+					},
+				},
+			},
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Posture check ID",
+			MarkdownDescription: "Posture check ID",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Posture check name identifier",
+			MarkdownDescription: "Posture check name identifier",
+		},
+		"nb_version_check": schema.SingleNestedAttribute{
+			Computed:            true,
+			Description:         "Posture check for the version of NetBird",
+			MarkdownDescription: "Posture check for the version of NetBird",
+			Attributes:          minVersionAttributes,
+		},
+		"os_version_check": schema.SingleNestedAttribute{
+			Computed:            true,
+			Description:         "Posture check for the version of operating system",
+			MarkdownDescription: "Posture check for the version of operating system",
+			Attributes: map[string]schema.Attribute{
+				"android": schema.SingleNestedAttribute{
+					Computed:            true,
+					Description:         "Minimum Android version",
+					MarkdownDescription: "Minimum Android version",
+					Attributes:          minVersionAttributes,
+				},
+				"darwin": schema.SingleNestedAttribute{
+					Computed:            true,
+					Description:         "Minimum macOS version",
+					MarkdownDescription: "Minimum macOS version",
+					Attributes:          minVersionAttributes,
+				},
+				"ios": schema.SingleNestedAttribute{
+					Computed:            true,
+					Description:         "Minimum iOS version",
+					MarkdownDescription: "Minimum iOS version",
+					Attributes:          minVersionAttributes,
+				},
+				"linux": schema.SingleNestedAttribute{
+					Computed:            true,
+					Description:         "Minimum Linux kernel version",
+					MarkdownDescription: "Minimum Linux kernel version",
+					Attributes:          minKernelVersionAttributes,
+				},
+				"windows": schema.SingleNestedAttribute{
+					Computed:            true,
+					Description:         "Minimum Windows kernel version",
+					MarkdownDescription: "Minimum Windows kernel version",
+					Attributes:          minKernelVersionAttributes,
+				},
+			},
+		},
+		"peer_network_range_check": schema.SingleNestedAttribute{
+			Computed:            true,
+			Description:         "Posture check for allow or deny access based on peer local network addresses",
+			MarkdownDescription: "Posture check for allow or deny access based on peer local network addresses",
+			Attributes: map[string]schema.Attribute{
+				"action": schema.StringAttribute{
+					Computed:            true,
+					Description:         "Action to take upon policy match",
+					MarkdownDescription: "Action to take upon policy match",
+				},
+				"ranges": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Computed:            true,
+					Description:         "List of peer network ranges in CIDR notation",
+					MarkdownDescription: "List of peer network ranges in CIDR notation",
+				},
+			},
+		},
+		"process_check": schema.SingleNestedAttribute{
+			Computed:            true,
+			Description:         "Posture Check for binaries exist and are running in the peer's system",
+			MarkdownDescription: "Posture Check for binaries exist and are running in the peer's system",
+			Attributes: map[string]schema.Attribute{
+				"processes": schema.ListNestedAttribute{
+					Computed:            true,
+					Description:         "List of processes to check",
+					MarkdownDescription: "List of processes to check",
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"linux_path": schema.StringAttribute{
+								Computed:            true,
+								Description:         "Path to the process executable file in a Linux operating system",
+								MarkdownDescription: "Path to the process executable file in a Linux operating system",
+							},
+							"mac_path": schema.StringAttribute{
+								Computed:            true,
+								Description:         "Path to the process executable file in a Mac operating system",
+								MarkdownDescription: "Path to the process executable file in a Mac operating system",
+							},
+							"windows_path": schema.StringAttribute{
+								Computed:            true,
+								Description:         "Path to the process executable file in a Windows operating system",
+								MarkdownDescription: "Path to the process executable file in a Windows operating system",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *postureCheckDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *postureCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *postureCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data postureCheckResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var postureCheck *sdk.PostureCheck
+	if !data.Id.IsNull() {
+		res, err := d.client.GetApiPostureChecksPostureCheckIdWithResponse(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failure to invoke get posture check API", err.Error())
+			return
+		}
+
+		if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(err))
+			return
+		}
+		postureCheck = res.JSON200
+	} else {
+		postureChecks, diags := listPostureChecks(ctx, d.client)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		var matches []sdk.PostureCheck
+		for _, check := range postureChecks {
+			if check.Name == data.Name.ValueString() {
+				matches = append(matches, check)
+			}
+		}
+
+		// Posture check names are unique within an account.
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError("posture check not found", fmt.Sprintf("No posture check named %q exists.", data.Name.ValueString()))
+			return
+		}
+		postureCheck = &matches[0]
+	}
+
+	data, diags := toPostureCheckModel(ctx, postureCheck)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listPostureChecks(ctx context.Context, client *sdk.ClientWithResponses) ([]sdk.PostureCheck, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.GetApiPostureChecksWithResponse(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke list posture checks API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	if res.JSON200 == nil {
+		return []sdk.PostureCheck{}, diags
+	}
+	return *res.JSON200, diags
+}
@@ -0,0 +1,595 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*postureCheckResource)(nil)
+var _ resource.ResourceWithImportState = (*postureCheckResource)(nil)
+var _ resource.ResourceWithConfigValidators = (*postureCheckResource)(nil)
+
+func NewPostureCheckResource() resource.Resource {
+	return &postureCheckResource{}
+}
+
+type postureCheckResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type postureCheckResourceModel struct {
+	Description           types.String                `tfsdk:"description"`
+	GeoLocationCheck      *geoLocationCheckModel      `tfsdk:"geo_location_check"`
+	Id                    types.String                `tfsdk:"id"`
+	Name                  types.String                `tfsdk:"name"`
+	NbVersionCheck        *minVersionCheckModel       `tfsdk:"nb_version_check"`
+	OsVersionCheck        *osVersionCheckModel        `tfsdk:"os_version_check"`
+	PeerNetworkRangeCheck *peerNetworkRangeCheckModel `tfsdk:"peer_network_range_check"`
+	ProcessCheck          *processCheckModel          `tfsdk:"process_check"`
+}
+
+type geoLocationCheckModel struct {
+	Action    types.String    `tfsdk:"action"`
+	Locations []locationModel `tfsdk:"locations"`
+}
+
+type locationModel struct {
+	CityName    types.String `tfsdk:"city_name"`
+	CountryCode types.String `tfsdk:"country_code"`
+}
+
+type minVersionCheckModel struct {
+	MinVersion types.String `tfsdk:"min_version"`
+}
+
+type minKernelVersionCheckModel struct {
+	MinKernelVersion types.String `tfsdk:"min_kernel_version"`
+}
+
+type osVersionCheckModel struct {
+	Android *minVersionCheckModel       `tfsdk:"android"`
+	Darwin  *minVersionCheckModel       `tfsdk:"darwin"`
+	Ios     *minVersionCheckModel       `tfsdk:"ios"`
+	Linux   *minKernelVersionCheckModel `tfsdk:"linux"`
+	Windows *minKernelVersionCheckModel `tfsdk:"windows"`
+}
+
+type peerNetworkRangeCheckModel struct {
+	Action types.String `tfsdk:"action"`
+	Ranges types.List   `tfsdk:"ranges"`
+}
+
+type processCheckModel struct {
+	Processes []processModel `tfsdk:"processes"`
+}
+
+type processModel struct {
+	LinuxPath   types.String `tfsdk:"linux_path"`
+	MacPath     types.String `tfsdk:"mac_path"`
+	WindowsPath types.String `tfsdk:"windows_path"`
+}
+
+func (r *postureCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_posture_check"
+}
+
+func (r *postureCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	minVersionAttributes := map[string]schema.Attribute{
+		"min_version": schema.StringAttribute{
+			Required:            true,
+			Description:         "Minimum acceptable version",
+			MarkdownDescription: "Minimum acceptable version",
+		},
+	}
+	minKernelVersionAttributes := map[string]schema.Attribute{
+		"min_kernel_version": schema.StringAttribute{
+			Required:            true,
+			Description:         "Minimum acceptable version",
+			MarkdownDescription: "Minimum acceptable version",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Posture check friendly description",
+				MarkdownDescription: "Posture check friendly description",
+			},
+			"geo_location_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "Posture check for geo location",
+				MarkdownDescription: "Posture check for geo location",
+				Attributes: map[string]schema.Attribute{
+					"action": schema.StringAttribute{
+						Required:            true,
+						Description:         "Action to take upon policy match",
+						MarkdownDescription: "Action to take upon policy match",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(sdk.GeoLocationCheckActionAllow),
+								string(sdk.GeoLocationCheckActionDeny),
+							),
+						},
+					},
+					"locations": schema.ListNestedAttribute{
+						Required:            true,
+						Description:         "List of geo locations to which the policy applies",
+						MarkdownDescription: "List of geo locations to which the policy applies",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"city_name": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Commonly used English name of the city",
+									MarkdownDescription: "Commonly used English name of the city",
+								},
+								"country_code": schema.StringAttribute{
+									Required:            true,
+									Description:         "2-letter ISO 3166-1 alpha-2 code that represents the country",
+									MarkdownDescription: "2-letter ISO 3166-1 alpha-2 code that represents the country",
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(2, 2),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Posture check ID",
+				MarkdownDescription: "Posture check ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Posture check name identifier",
+				MarkdownDescription: "Posture check name identifier",
+			},
+			"nb_version_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "Posture check for the version of NetBird",
+				MarkdownDescription: "Posture check for the version of NetBird",
+				Attributes:          minVersionAttributes,
+			},
+			"os_version_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "Posture check for the version of operating system",
+				MarkdownDescription: "Posture check for the version of operating system",
+				Attributes: map[string]schema.Attribute{
+					"android": schema.SingleNestedAttribute{
+						Optional:            true,
+						Description:         "Minimum Android version",
+						MarkdownDescription: "Minimum Android version",
+						Attributes:          minVersionAttributes,
+					},
+					"darwin": schema.SingleNestedAttribute{
+						Optional:            true,
+						Description:         "Minimum macOS version",
+						MarkdownDescription: "Minimum macOS version",
+						Attributes:          minVersionAttributes,
+					},
+					"ios": schema.SingleNestedAttribute{
+						Optional:            true,
+						Description:         "Minimum iOS version",
+						MarkdownDescription: "Minimum iOS version",
+						Attributes:          minVersionAttributes,
+					},
+					"linux": schema.SingleNestedAttribute{
+						Optional:            true,
+						Description:         "Minimum Linux kernel version",
+						MarkdownDescription: "Minimum Linux kernel version",
+						Attributes:          minKernelVersionAttributes,
+					},
+					"windows": schema.SingleNestedAttribute{
+						Optional:            true,
+						Description:         "Minimum Windows kernel version",
+						MarkdownDescription: "Minimum Windows kernel version",
+						Attributes:          minKernelVersionAttributes,
+					},
+				},
+			},
+			"peer_network_range_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "Posture check for allow or deny access based on peer local network addresses",
+				MarkdownDescription: "Posture check for allow or deny access based on peer local network addresses",
+				Attributes: map[string]schema.Attribute{
+					"action": schema.StringAttribute{
+						Required:            true,
+						Description:         "Action to take upon policy match",
+						MarkdownDescription: "Action to take upon policy match",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(sdk.PeerNetworkRangeCheckActionAllow),
+								string(sdk.PeerNetworkRangeCheckActionDeny),
+							),
+						},
+					},
+					"ranges": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Required:            true,
+						Description:         "List of peer network ranges in CIDR notation",
+						MarkdownDescription: "List of peer network ranges in CIDR notation",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"process_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "Posture Check for binaries exist and are running in the peer's system",
+				MarkdownDescription: "Posture Check for binaries exist and are running in the peer's system",
+				Attributes: map[string]schema.Attribute{
+					"processes": schema.ListNestedAttribute{
+						Required:            true,
+						Description:         "List of processes to check",
+						MarkdownDescription: "List of processes to check",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"linux_path": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Path to the process executable file in a Linux operating system",
+									MarkdownDescription: "Path to the process executable file in a Linux operating system",
+								},
+								"mac_path": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Path to the process executable file in a Mac operating system",
+									MarkdownDescription: "Path to the process executable file in a Mac operating system",
+								},
+								"windows_path": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Path to the process executable file in a Windows operating system",
+									MarkdownDescription: "Path to the process executable file in a Windows operating system",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *postureCheckResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("geo_location_check"),
+			path.MatchRoot("nb_version_check"),
+			path.MatchRoot("os_version_check"),
+			path.MatchRoot("peer_network_range_check"),
+			path.MatchRoot("process_check"),
+		),
+	}
+}
+
+func (r *postureCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *postureCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data postureCheckResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostApiPostureChecksWithResponse(ctx, toPostureCheckApiRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke create posture check API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	postureCheck, diags := toPostureCheckModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &postureCheck)...)
+}
+
+func (r *postureCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data postureCheckResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetApiPostureChecksPostureCheckIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke get posture check API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	postureCheck, diags := toPostureCheckModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &postureCheck)...)
+}
+
+func (r *postureCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state postureCheckResourceModel
+	var plan postureCheckResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PutApiPostureChecksPostureCheckIdWithResponse(ctx, state.Id.ValueString(), toPostureCheckApiRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke update posture check API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, state.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	postureCheck, diags := toPostureCheckModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &postureCheck)...)
+}
+
+func (r *postureCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data postureCheckResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteApiPostureChecksPostureCheckIdWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke delete posture check API", err.Error())
+		return
+	}
+
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+}
+
+func (r *postureCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func toPostureCheckApiRequest(data postureCheckResourceModel) sdk.PostureCheckUpdate {
+	checks := sdk.Checks{}
+
+	if data.GeoLocationCheck != nil {
+		locations := make([]sdk.Location, len(data.GeoLocationCheck.Locations))
+		for i, v := range data.GeoLocationCheck.Locations {
+			var cityName *sdk.CityName
+			if !v.CityName.IsUnknown() && !v.CityName.IsNull() {
+				cityName = v.CityName.ValueStringPointer()
+			}
+			locations[i] = sdk.Location{
+				CityName:    cityName,
+				CountryCode: v.CountryCode.ValueString(),
+			}
+		}
+		checks.GeoLocationCheck = &sdk.GeoLocationCheck{
+			Action:    sdk.GeoLocationCheckAction(data.GeoLocationCheck.Action.ValueString()),
+			Locations: locations,
+		}
+	}
+
+	if data.NbVersionCheck != nil {
+		checks.NbVersionCheck = toMinVersionCheck(data.NbVersionCheck)
+	}
+
+	if data.OsVersionCheck != nil {
+		checks.OsVersionCheck = &sdk.OSVersionCheck{
+			Android: toMinVersionCheck(data.OsVersionCheck.Android),
+			Darwin:  toMinVersionCheck(data.OsVersionCheck.Darwin),
+			Ios:     toMinVersionCheck(data.OsVersionCheck.Ios),
+			Linux:   toMinKernelVersionCheck(data.OsVersionCheck.Linux),
+			Windows: toMinKernelVersionCheck(data.OsVersionCheck.Windows),
+		}
+	}
+
+	if data.PeerNetworkRangeCheck != nil {
+		checks.PeerNetworkRangeCheck = &sdk.PeerNetworkRangeCheck{
+			Action: sdk.PeerNetworkRangeCheckAction(data.PeerNetworkRangeCheck.Action.ValueString()),
+			Ranges: toStringSlice(data.PeerNetworkRangeCheck.Ranges),
+		}
+	}
+
+	if data.ProcessCheck != nil {
+		processes := make([]sdk.Process, len(data.ProcessCheck.Processes))
+		for i, v := range data.ProcessCheck.Processes {
+			processes[i] = sdk.Process{
+				LinuxPath:   v.LinuxPath.ValueStringPointer(),
+				MacPath:     v.MacPath.ValueStringPointer(),
+				WindowsPath: v.WindowsPath.ValueStringPointer(),
+			}
+		}
+		checks.ProcessCheck = &sdk.ProcessCheck{
+			Processes: processes,
+		}
+	}
+
+	description := ""
+	if !data.Description.IsUnknown() && !data.Description.IsNull() {
+		description = data.Description.ValueString()
+	}
+
+	return sdk.PostureCheckUpdate{
+		Checks:      &checks,
+		Description: description,
+		Name:        data.Name.ValueString(),
+	}
+}
+
+func toMinVersionCheck(data *minVersionCheckModel) *sdk.MinVersionCheck {
+	if data == nil {
+		return nil
+	}
+	return &sdk.MinVersionCheck{
+		MinVersion: data.MinVersion.ValueString(),
+	}
+}
+
+func toMinKernelVersionCheck(data *minKernelVersionCheckModel) *sdk.MinKernelVersionCheck {
+	if data == nil {
+		return nil
+	}
+	return &sdk.MinKernelVersionCheck{
+		MinKernelVersion: data.MinKernelVersion.ValueString(),
+	}
+}
+
+func toPostureCheckModel(ctx context.Context, data *sdk.PostureCheck) (postureCheckResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	description := ""
+	if data.Description != nil {
+		description = *data.Description
+	}
+
+	model := postureCheckResourceModel{
+		Description: types.StringValue(description),
+		Id:          types.StringValue(data.Id),
+		Name:        types.StringValue(data.Name),
+	}
+
+	if check := data.Checks.GeoLocationCheck; check != nil {
+		locations := make([]locationModel, len(check.Locations))
+		for i, v := range check.Locations {
+			locations[i] = locationModel{
+				CityName:    types.StringPointerValue(v.CityName),
+				CountryCode: types.StringValue(v.CountryCode),
+			}
+		}
+		model.GeoLocationCheck = &geoLocationCheckModel{
+			Action:    types.StringValue(string(check.Action)),
+			Locations: locations,
+		}
+	}
+
+	model.NbVersionCheck = toMinVersionCheckModel(data.Checks.NbVersionCheck)
+
+	if check := data.Checks.OsVersionCheck; check != nil {
+		model.OsVersionCheck = &osVersionCheckModel{
+			Android: toMinVersionCheckModel(check.Android),
+			Darwin:  toMinVersionCheckModel(check.Darwin),
+			Ios:     toMinVersionCheckModel(check.Ios),
+			Linux:   toMinKernelVersionCheckModel(check.Linux),
+			Windows: toMinKernelVersionCheckModel(check.Windows),
+		}
+	}
+
+	if check := data.Checks.PeerNetworkRangeCheck; check != nil {
+		ranges, d := types.ListValueFrom(ctx, types.StringType, check.Ranges)
+		diags.Append(d...)
+		model.PeerNetworkRangeCheck = &peerNetworkRangeCheckModel{
+			Action: types.StringValue(string(check.Action)),
+			Ranges: ranges,
+		}
+	}
+
+	if check := data.Checks.ProcessCheck; check != nil {
+		processes := make([]processModel, len(check.Processes))
+		for i, v := range check.Processes {
+			processes[i] = processModel{
+				LinuxPath:   types.StringPointerValue(v.LinuxPath),
+				MacPath:     types.StringPointerValue(v.MacPath),
+				WindowsPath: types.StringPointerValue(v.WindowsPath),
+			}
+		}
+		model.ProcessCheck = &processCheckModel{
+			Processes: processes,
+		}
+	}
+
+	return model, diags
+}
+
+func toMinVersionCheckModel(data *sdk.MinVersionCheck) *minVersionCheckModel {
+	if data == nil {
+		return nil
+	}
+	return &minVersionCheckModel{
+		MinVersion: types.StringValue(data.MinVersion),
+	}
+}
+
+func toMinKernelVersionCheckModel(data *sdk.MinKernelVersionCheck) *minKernelVersionCheckModel {
+	if data == nil {
+		return nil
+	}
+	return &minKernelVersionCheckModel{
+		MinKernelVersion: types.StringValue(data.MinKernelVersion),
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*postureChecksDataSource)(nil)
+
+func NewPostureChecksDataSource() datasource.DataSource {
+	return &postureChecksDataSource{}
+}
+
+type postureChecksDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type postureChecksDataSourceModel struct {
+	Group         types.String                `tfsdk:"group"`
+	Ids           types.List                  `tfsdk:"ids"`
+	Name          types.String                `tfsdk:"name"`
+	PostureChecks []postureCheckResourceModel `tfsdk:"posture_checks"`
+}
+
+func (d *postureChecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_posture_checks"
+}
+
+func (d *postureChecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists posture checks, optionally filtered. All filters that are set must match for a posture check to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return posture checks applied by a policy with a rule using this group ID as a source or destination",
+				MarkdownDescription: "Only return posture checks applied by a policy with a rule using this group ID as a source or destination",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the posture checks matching the filters",
+				MarkdownDescription: "IDs of the posture checks matching the filters",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return posture checks with this name",
+				MarkdownDescription: "Only return posture checks with this name",
+			},
+			"posture_checks": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Posture checks matching the filters",
+				MarkdownDescription: "Posture checks matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: postureCheckDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *postureChecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *postureChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data postureChecksDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Posture checks don't reference groups themselves, they are tied to
+	// groups through the policies applying them.
+	var groupPostureChecks []string
+	if !data.Group.IsNull() {
+		policies, diags := listPolicies(ctx, d.client)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		groupPostureChecks = []string{}
+		for _, policy := range policies {
+			if policyReferencesGroup(policy, data.Group.ValueString()) {
+				groupPostureChecks = append(groupPostureChecks, policy.SourcePostureChecks...)
+			}
+		}
+	}
+
+	postureChecks, diags := listPostureChecks(ctx, d.client)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data.PostureChecks = []postureCheckResourceModel{}
+	postureCheckIds := []string{}
+	for _, check := range postureChecks {
+		if groupPostureChecks != nil && !slices.Contains(groupPostureChecks, check.Id) {
+			continue
+		}
+		if !data.Name.IsNull() && check.Name != data.Name.ValueString() {
+			continue
+		}
+
+		model, diags := toPostureCheckModel(ctx, &check)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		data.PostureChecks = append(data.PostureChecks, model)
+		postureCheckIds = append(postureCheckIds, check.Id)
+	}
+
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, postureCheckIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,19 +2,42 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/netbirdio/terraform-provider-netbird/internal/httpclient"
+	"github.com/netbirdio/terraform-provider-netbird/internal/oidc"
 	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
 )
 
 var _ provider.Provider = (*netbirdProvider)(nil)
 
+const (
+	defaultServerURL = "https://api.netbird.io"
+
+	// Environment variables used when the matching provider attribute isn't set.
+	envToken         = "NETBIRD_TOKEN"
+	envManagementURL = "NETBIRD_MANAGEMENT_URL"
+	envConfigFile    = "NETBIRD_CONFIG_FILE"
+)
+
 func New() func() provider.Provider {
 	return func() provider.Provider {
 		return &netbirdProvider{}
@@ -26,22 +49,174 @@ type netbirdProvider struct {
 
 // NetbirdProviderModel describes the provider data model.
 type NetbirdProviderModel struct {
-	ServerURL types.String `tfsdk:"server_url"`
-	TokenAuth types.String `tfsdk:"token_auth"`
+	CACertFile            types.String  `tfsdk:"ca_cert_file"`
+	CACertPEM             types.String  `tfsdk:"ca_cert_pem"`
+	ClientCert            types.String  `tfsdk:"client_cert"`
+	ClientKey             types.String  `tfsdk:"client_key"`
+	ConfigFile            types.String  `tfsdk:"config_file"`
+	InsecureSkipVerify    types.Bool    `tfsdk:"insecure_skip_verify"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	OIDC                  *oidcModel    `tfsdk:"oidc"`
+	ProxyURL              types.String  `tfsdk:"proxy_url"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Retry                 *retryModel   `tfsdk:"retry"`
+	ServerURL             types.String  `tfsdk:"server_url"`
+	TokenAuth             types.String  `tfsdk:"token_auth"`
+}
+
+// retryModel describes the retry block of the provider.
+type retryModel struct {
+	Jitter     types.Bool   `tfsdk:"jitter"`
+	MaxBackoff types.String `tfsdk:"max_backoff"`
+	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	MinBackoff types.String `tfsdk:"min_backoff"`
+}
+
+// oidcModel describes the oidc block of the provider.
+type oidcModel struct {
+	Audience     types.String `tfsdk:"audience"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	IssuerURL    types.String `tfsdk:"issuer_url"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	TokenURL     types.String `tfsdk:"token_url"`
+}
+
+// netbirdConfigFile is the JSON file pointed to by config_file.
+type netbirdConfigFile struct {
+	ManagementURL string `json:"management_url"`
+	Token         string `json:"token"`
 }
 
 func (p *netbirdProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: `NetBird REST API: API to manipulate groups, rules, policies and retrieve information about peers and users`,
+		MarkdownDescription: "NetBird REST API: API to manipulate groups, rules, policies and retrieve information about peers and users\n\n" +
+			"The server URL and the token are taken from the provider block first, then from the `" + envManagementURL + "` and `" + envToken + "` environment variables " +
+			"and last from the config file set with `config_file` or the `" + envConfigFile + "` environment variable. The server URL defaults to https://api.netbird.io.",
 		Attributes: map[string]schema.Attribute{
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded bundle of certificate authorities trusted for the server certificate, in addition to the system ones",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded bundle of certificate authorities trusted for the server certificate, in addition to the system ones",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate presented to the server for mutual TLS",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_cert`",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a JSON file with `management_url` and `token` keys, used for the settings that are neither set in the provider block nor in the environment. Can also be set with the `" + envConfigFile + "` environment variable.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Don't verify the certificate of the server. This makes the connection vulnerable to man-in-the-middle attacks, only use it for testing.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API calls in flight at once, shared by all resources and data sources (defaults to unlimited)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to reach the server (defaults to the proxy of the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables)",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum duration of a single API call, such as `30s` (defaults to no limit)",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of API calls, shared by all resources and data sources. Retries count against it too (defaults to unlimited)",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
 			"server_url": schema.StringAttribute{
-				MarkdownDescription: "Server URL (defaults to https://api.netbird.io)",
+				MarkdownDescription: "Server URL (defaults to https://api.netbird.io). Can also be set with the `" + envManagementURL + "` environment variable.",
 				Optional:            true,
 				Required:            false,
 			},
 			"token_auth": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				MarkdownDescription: "Personal access token used to authenticate against the API. Can also be set with the `" + envToken + "` environment variable. Not used when the `oidc` block is set.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"oidc": schema.SingleNestedBlock{
+				MarkdownDescription: "Authenticate with Bearer tokens obtained from an identity provider through the OAuth2 client credentials grant, instead of a personal access token. Tokens are cached and refreshed shortly before they expire.",
+				Attributes: map[string]schema.Attribute{
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "Audience requested for the token, required by some identity providers such as Auth0",
+						Optional:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client ID of the application",
+						Optional:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret of the application",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"issuer_url": schema.StringAttribute{
+						MarkdownDescription: "Issuer URL, used to discover the token endpoint. Exactly one of `issuer_url` or `token_url` must be set.",
+						Optional:            true,
+					},
+					"scopes": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Scopes requested for the token",
+						Optional:            true,
+					},
+					"token_url": schema.StringAttribute{
+						MarkdownDescription: "Token endpoint of the identity provider. Exactly one of `issuer_url` or `token_url` must be set.",
+						Optional:            true,
+					},
+				},
+			},
+			"retry": schema.SingleNestedBlock{
+				MarkdownDescription: "Retries of failed API calls with exponential backoff. Rate limited and unavailable responses are always retried, other server and network errors only for idempotent requests. A `Retry-After` header sent by the server takes precedence over the backoff, calls asked to wait longer than `max_backoff` fail without being retried. Retries are enabled with the defaults below even without this block.",
+				Attributes: map[string]schema.Attribute{
+					"jitter": schema.BoolAttribute{
+						MarkdownDescription: "Randomize each wait between half and all of the backoff (defaults to true)",
+						Optional:            true,
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "Longest wait between two attempts (defaults to 30s)",
+						Optional:            true,
+					},
+					"max_retries": schema.Int64Attribute{
+						MarkdownDescription: "Number of retries after the first attempt, 0 disables retries (defaults to 3)",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						MarkdownDescription: "Wait before the first retry, doubled for every following retry (defaults to 1s)",
+						Optional:            true,
+					},
+				},
 			},
 		},
 	}
@@ -56,16 +231,83 @@ func (p *netbirdProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	serverURL := data.ServerURL.ValueString()
-	if serverURL == "" {
-		serverURL = "https://api.netbird.io"
+	resp.Diagnostics.Append(checkKnown(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings in the provider block win over environment variables, which
+	// win over the config file.
+	configFile := firstNonEmpty(data.ConfigFile.ValueString(), os.Getenv(envConfigFile))
+	var fileConfig netbirdConfigFile
+	if configFile != "" {
+		content, err := os.ReadFile(configFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("config_file"), "failed to read NetBird config file", err.Error())
+			return
+		}
+		if err := json.Unmarshal(content, &fileConfig); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("config_file"), "failed to parse NetBird config file", fmt.Sprintf("%s: %s", configFile, err))
+			return
+		}
 	}
 
-	addRequestAuth := func(ctx context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", "Token "+data.TokenAuth.ValueString())
-		return nil
+	serverURL := firstNonEmpty(data.ServerURL.ValueString(), os.Getenv(envManagementURL), fileConfig.ManagementURL, defaultServerURL)
+
+	httpClient, diags := toHTTPClient(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	client, err := sdk.NewClientWithResponses(serverURL, sdk.WithRequestEditorFn(addRequestAuth))
+
+	var addRequestAuth sdk.RequestEditorFn
+	if data.OIDC != nil {
+		tokenSource, diags := toClientCredentials(ctx, data, httpClient)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		addRequestAuth = func(ctx context.Context, req *http.Request) error {
+			token, err := tokenSource.Token(ctx)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+			return nil
+		}
+	} else {
+		token := firstNonEmpty(data.TokenAuth.ValueString(), os.Getenv(envToken), fileConfig.Token)
+		if token == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_auth"),
+				"Missing NetBird API token",
+				fmt.Sprintf("Set token_auth in the provider block, the %s environment variable, the token key of the config file or configure the oidc block.", envToken),
+			)
+			return
+		}
+
+		addRequestAuth = func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Token "+token)
+			return nil
+		}
+	}
+
+	retryPolicy, diags := toRetryPolicy(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limits := httpclient.Limits{
+		RequestsPerSecond:     data.RequestsPerSecond.ValueFloat64(),
+		MaxConcurrentRequests: int(data.MaxConcurrentRequests.ValueInt64()),
+	}
+
+	client, err := sdk.NewClientWithResponses(serverURL,
+		sdk.WithRequestEditorFn(addRequestAuth),
+		sdk.WithHTTPClient(httpclient.WithRetries(httpclient.WithLimits(httpClient, limits), retryPolicy)),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create client", err.Error())
 		return
@@ -74,15 +316,234 @@ func (p *netbirdProvider) Configure(ctx context.Context, req provider.ConfigureR
 	resp.ResourceData = client
 }
 
+// toClientCredentials validates the oidc block and builds the token source
+// shared by all API calls.
+func toClientCredentials(ctx context.Context, data NetbirdProviderModel, httpClient *http.Client) (*oidc.ClientCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.TokenAuth.IsNull() {
+		diags.AddAttributeError(path.Root("token_auth"), "Conflicting NetBird authentication settings", "token_auth can't be set together with the oidc block.")
+	}
+	if data.OIDC.ClientID.ValueString() == "" {
+		diags.AddAttributeError(path.Root("oidc").AtName("client_id"), "Missing OIDC client ID", "client_id is required in the oidc block.")
+	}
+	if data.OIDC.ClientSecret.ValueString() == "" {
+		diags.AddAttributeError(path.Root("oidc").AtName("client_secret"), "Missing OIDC client secret", "client_secret is required in the oidc block.")
+	}
+	if (data.OIDC.IssuerURL.ValueString() == "") == (data.OIDC.TokenURL.ValueString() == "") {
+		diags.AddAttributeError(path.Root("oidc"), "Invalid OIDC settings", "Exactly one of issuer_url or token_url must be set in the oidc block.")
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var scopes []string
+	diags.Append(data.OIDC.Scopes.ElementsAs(ctx, &scopes, false)...)
+
+	return &oidc.ClientCredentials{
+		IssuerURL:    data.OIDC.IssuerURL.ValueString(),
+		TokenURL:     data.OIDC.TokenURL.ValueString(),
+		ClientID:     data.OIDC.ClientID.ValueString(),
+		ClientSecret: data.OIDC.ClientSecret.ValueString(),
+		Audience:     data.OIDC.Audience.ValueString(),
+		Scopes:       scopes,
+		HTTPClient:   httpClient,
+	}, diags
+}
+
+// providerSetting is a provider attribute checked by checkKnown.
+type providerSetting struct {
+	path  path.Path
+	value attr.Value
+}
+
+// checkKnown fails for settings whose value isn't known yet, such as values
+// derived from resources that don't exist yet, rather than treating them as
+// unset.
+func checkKnown(data NetbirdProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings := []providerSetting{
+		{path.Root("config_file"), data.ConfigFile},
+		{path.Root("server_url"), data.ServerURL},
+		{path.Root("token_auth"), data.TokenAuth},
+		{path.Root("requests_per_second"), data.RequestsPerSecond},
+		{path.Root("max_concurrent_requests"), data.MaxConcurrentRequests},
+		{path.Root("ca_cert_file"), data.CACertFile},
+		{path.Root("ca_cert_pem"), data.CACertPEM},
+		{path.Root("client_cert"), data.ClientCert},
+		{path.Root("client_key"), data.ClientKey},
+		{path.Root("insecure_skip_verify"), data.InsecureSkipVerify},
+		{path.Root("proxy_url"), data.ProxyURL},
+		{path.Root("request_timeout"), data.RequestTimeout},
+	}
+	if data.OIDC != nil {
+		oidcPath := path.Root("oidc")
+		settings = append(settings, []providerSetting{
+			{oidcPath.AtName("audience"), data.OIDC.Audience},
+			{oidcPath.AtName("client_id"), data.OIDC.ClientID},
+			{oidcPath.AtName("client_secret"), data.OIDC.ClientSecret},
+			{oidcPath.AtName("issuer_url"), data.OIDC.IssuerURL},
+			{oidcPath.AtName("scopes"), data.OIDC.Scopes},
+			{oidcPath.AtName("token_url"), data.OIDC.TokenURL},
+		}...)
+	}
+	if data.Retry != nil {
+		retryPath := path.Root("retry")
+		settings = append(settings, []providerSetting{
+			{retryPath.AtName("jitter"), data.Retry.Jitter},
+			{retryPath.AtName("max_backoff"), data.Retry.MaxBackoff},
+			{retryPath.AtName("max_retries"), data.Retry.MaxRetries},
+			{retryPath.AtName("min_backoff"), data.Retry.MinBackoff},
+		}...)
+	}
+
+	for _, setting := range settings {
+		if setting.value.IsUnknown() {
+			diags.AddAttributeError(
+				setting.path,
+				"Unknown NetBird provider setting",
+				fmt.Sprintf("The value of %s isn't known yet, set it to a static value or through the environment.", setting.path),
+			)
+		}
+	}
+
+	return diags
+}
+
+// toHTTPClient builds the HTTP client from the TLS, proxy and timeout settings.
+func toHTTPClient(data NetbirdProviderModel) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := httpclient.Options{
+		CACertPEM:          []byte(data.CACertPEM.ValueString()),
+		ClientCertPEM:      []byte(data.ClientCert.ValueString()),
+		ClientKeyPEM:       []byte(data.ClientKey.ValueString()),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	}
+
+	if !data.CACertFile.IsNull() {
+		content, err := os.ReadFile(data.CACertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "failed to read CA certificates", err.Error())
+			return nil, diags
+		}
+		opts.CACertPEM = content
+	}
+
+	if !data.ProxyURL.IsNull() {
+		proxyURL, err := url.Parse(data.ProxyURL.ValueString())
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid proxy URL", fmt.Sprintf("Expected an absolute URL such as \"http://proxy:3128\", got %q.", data.ProxyURL.ValueString()))
+			return nil, diags
+		}
+		opts.ProxyURL = proxyURL
+	}
+
+	var d diag.Diagnostics
+	opts.Timeout, d = parseDuration(path.Root("request_timeout"), data.RequestTimeout, 0)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if opts.InsecureSkipVerify {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS certificate verification is disabled",
+			"The certificate of the NetBird server isn't verified, anyone able to intercept the connection can read and modify API calls, including the credentials sent with them. Configure ca_cert_pem or ca_cert_file instead.",
+		)
+	}
+
+	httpClient, err := httpclient.New(opts)
+	if err != nil {
+		diags.AddError("failed to configure the HTTP client", err.Error())
+		return nil, diags
+	}
+
+	return httpClient, diags
+}
+
+// toRetryPolicy validates the retry block, falling back to the defaults for
+// the settings it doesn't set.
+func toRetryPolicy(data NetbirdProviderModel) (httpclient.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := httpclient.DefaultRetryPolicy
+	if data.Retry == nil {
+		return policy, diags
+	}
+
+	if !data.Retry.MaxRetries.IsNull() {
+		policy.MaxRetries = int(data.Retry.MaxRetries.ValueInt64())
+	}
+	if !data.Retry.Jitter.IsNull() {
+		policy.Jitter = data.Retry.Jitter.ValueBool()
+	}
+
+	var d diag.Diagnostics
+	policy.MinBackoff, d = parseDuration(path.Root("retry").AtName("min_backoff"), data.Retry.MinBackoff, policy.MinBackoff)
+	diags.Append(d...)
+	policy.MaxBackoff, d = parseDuration(path.Root("retry").AtName("max_backoff"), data.Retry.MaxBackoff, policy.MaxBackoff)
+	diags.Append(d...)
+	if !diags.HasError() && policy.MinBackoff > policy.MaxBackoff {
+		diags.AddAttributeError(path.Root("retry"), "Invalid retry backoff", "min_backoff can't be longer than max_backoff.")
+	}
+
+	return policy, diags
+}
+
+// parseDuration parses a duration attribute such as "30s", returning fallback
+// when the attribute isn't set.
+func parseDuration(attr path.Path, value types.String, fallback time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return fallback, diags
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attr, "Invalid duration", fmt.Sprintf("Expected a positive duration such as \"500ms\" or \"1m\", got %q.", value.ValueString()))
+		return fallback, diags
+	}
+
+	return duration, diags
+}
+
+// firstNonEmpty returns the first of values that isn't empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 func (p *netbirdProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "netbird"
 }
 
 func (p *netbirdProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCitiesDataSource,
+		NewCountriesDataSource,
+		NewEventsDataSource,
 		NewGroupDataSource,
 		NewGroupsDataSource,
+		NewPeerDataSource,
+		NewPeersDataSource,
+		NewPoliciesDataSource,
+		NewPolicyDataSource,
+		NewPostureCheckDataSource,
+		NewPostureChecksDataSource,
 		NewRouteDataSource,
+		NewRoutesDataSource,
+		NewSetupKeyDataSource,
+		NewSetupKeysDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
 	}
 }
 
@@ -91,5 +552,14 @@ func (p *netbirdProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewSetupKeyResource,
 		NewGroupResource,
 		NewRouteResource,
+		NewPolicyResource,
+		NewPostureCheckResource,
+		NewNameserverGroupResource,
+		NewDnsSettingsResource,
+		NewAccountSettingsResource,
+		NewUserResource,
+		NewServiceUserResource,
+		NewPersonalAccessTokenResource,
+		NewPeerResource,
 	}
 }
@@ -33,8 +33,8 @@ func SetupKeyResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"expires_in": schema.Int64Attribute{
 				Required:            true,
-				Description:         "Expiration time in seconds",
-				MarkdownDescription: "Expiration time in seconds",
+				Description:         "Expiration time in seconds. For imported setup keys it is estimated from the expiration and last update dates until set in the configuration.",
+				MarkdownDescription: "Expiration time in seconds. For imported setup keys it is estimated from the expiration and last update dates until set in the configuration.",
 				Validators: []validator.Int64{
 					int64validator.Between(86400, 31536000),
 				},
@@ -2,22 +2,44 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
 )
 
 var _ datasource.DataSource = (*routeDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*routeDataSource)(nil)
 
 func NewRouteDataSource() datasource.DataSource {
 	return &routeDataSource{}
 }
 
-type routeDataSource struct{}
+type routeDataSource struct {
+	client *sdk.ClientWithResponses
+}
 
 type routeDataSourceModel struct {
-	Id types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Domains     types.List   `tfsdk:"domains"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Groups      types.List   `tfsdk:"groups"`
+	Id          types.String `tfsdk:"id"`
+	KeepRoute   types.Bool   `tfsdk:"keep_route"`
+	Masquerade  types.Bool   `tfsdk:"masquerade"`
+	Metric      types.Int64  `tfsdk:"metric"`
+	Network     types.String `tfsdk:"network"`
+	NetworkId   types.String `tfsdk:"network_id"`
+	NetworkType types.String `tfsdk:"network_type"`
+	Peer        types.String `tfsdk:"peer"`
+	PeerGroups  types.List   `tfsdk:"peer_groups"`
 }
 
 func (d *routeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -25,13 +47,124 @@ func (d *routeDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 }
 
 func (d *routeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := routeDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The unique identifier of a route",
+		MarkdownDescription: "The unique identifier of a route",
+	}
+	attributes["network_id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Route network identifier, to group HA routes",
+		MarkdownDescription: "Route network identifier, to group HA routes",
+	}
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed: true,
-			},
+		Description: "Looks up a route by ID or by network identifier. Exactly one of `id` or `network_id` must be set.",
+		Attributes:  attributes,
+	}
+}
+
+// routeDataSourceAttributes returns the computed attributes describing a
+// route, shared by the netbird_route and netbird_routes data sources.
+func routeDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"description": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Route description",
+			MarkdownDescription: "Route description",
+		},
+		"domains": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "Domain list to be dynamically resolved",
+			MarkdownDescription: "Domain list to be dynamically resolved",
+		},
+		"enabled": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Route status",
+			MarkdownDescription: "Route status",
+		},
+		"groups": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "Group IDs the route is distributed to",
+			MarkdownDescription: "Group IDs the route is distributed to",
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The unique identifier of a route",
+			MarkdownDescription: "The unique identifier of a route",
+		},
+		"keep_route": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Indicate if the route should be kept after a domain doesn't resolve that IP anymore",
+			MarkdownDescription: "Indicate if the route should be kept after a domain doesn't resolve that IP anymore",
+		},
+		"masquerade": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Indicate if peer should masquerade traffic to this route's prefix",
+			MarkdownDescription: "Indicate if peer should masquerade traffic to this route's prefix",
+		},
+		"metric": schema.Int64Attribute{
+			Computed:            true,
+			Description:         "Route metric number. Lowest number has higher priority",
+			MarkdownDescription: "Route metric number. Lowest number has higher priority",
 		},
+		"network": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Network range in CIDR format",
+			MarkdownDescription: "Network range in CIDR format",
+		},
+		"network_id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Route network identifier, to group HA routes",
+			MarkdownDescription: "Route network identifier, to group HA routes",
+		},
+		"network_type": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Network type indicating if it is a domain route or a IPv4/IPv6 route",
+			MarkdownDescription: "Network type indicating if it is a domain route or a IPv4/IPv6 route",
+		},
+		"peer": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Peer Identifier associated with route",
+			MarkdownDescription: "Peer Identifier associated with route",
+		},
+		"peer_groups": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "Peers Group Identifier associated with route",
+			MarkdownDescription: "Peers Group Identifier associated with route",
+		},
+	}
+}
+
+func (d *routeDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("network_id"),
+		),
+	}
+}
+
+func (d *routeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
+	d.client = client
 }
 
 func (d *routeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -44,11 +177,128 @@ func (d *routeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Read API call logic
+	var route *sdk.Route
+	if !data.Id.IsNull() {
+		res, err := d.client.GetApiRoutesRouteIdWithResponse(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failure to invoke get route API", err.Error())
+			return
+		}
 
-	// Example data value setting
-	data.Id = types.StringValue("example-id")
+		if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(err))
+			return
+		}
+		route = res.JSON200
+	} else {
+		var diags diag.Diagnostics
+		route, diags = findRouteByNetworkId(ctx, d.client, data.NetworkId.ValueString())
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	data, diags := toRouteDataSourceModel(ctx, route)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// findRouteByNetworkId returns the only route with the given network
+// identifier. HA routes share a network identifier, those have to be looked
+// up by id.
+func findRouteByNetworkId(ctx context.Context, client *sdk.ClientWithResponses, networkId string) (*sdk.Route, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.GetApiRoutesWithResponse(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke list routes API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	var matches []sdk.Route
+	if res.JSON200 != nil {
+		for _, route := range *res.JSON200 {
+			if route.NetworkId == networkId {
+				matches = append(matches, route)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("route not found", fmt.Sprintf("No route with network_id %q exists.", networkId))
+		return nil, diags
+	case 1:
+		return &matches[0], diags
+	default:
+		ids := make([]string, len(matches))
+		for i, route := range matches {
+			ids[i] = route.Id
+		}
+		diags.AddError("multiple routes found", fmt.Sprintf("%d routes have network_id %q (IDs: %v), look the route up by id instead.", len(matches), networkId, ids))
+		return nil, diags
+	}
+}
+
+func toRouteDataSourceModel(ctx context.Context, data *sdk.Route) (routeDataSourceModel, diag.Diagnostics) {
+	network := ""
+	if data.Network != nil {
+		network = *data.Network
+	}
+
+	peer := ""
+	if data.Peer != nil {
+		peer = *data.Peer
+	}
+
+	model := routeDataSourceModel{
+		Description: types.StringValue(data.Description),
+		Enabled:     types.BoolValue(data.Enabled),
+		Id:          types.StringValue(data.Id),
+		KeepRoute:   types.BoolValue(data.KeepRoute),
+		Masquerade:  types.BoolValue(data.Masquerade),
+		Metric:      types.Int64Value(int64(data.Metric)),
+		Network:     types.StringValue(network),
+		NetworkId:   types.StringValue(data.NetworkId),
+		NetworkType: types.StringValue(data.NetworkType),
+		Peer:        types.StringValue(peer),
+	}
+
+	domains := []string{}
+	if data.Domains != nil {
+		domains = *data.Domains
+	}
+
+	peerGroups := []string{}
+	if data.PeerGroups != nil {
+		peerGroups = *data.PeerGroups
+	}
+
+	groups := data.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	model.Domains, d = types.ListValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+	model.Groups, d = types.ListValueFrom(ctx, types.StringType, groups)
+	diags.Append(d...)
+	model.PeerGroups, d = types.ListValueFrom(ctx, types.StringType, peerGroups)
+	diags.Append(d...)
+
+	return model, diags
+}
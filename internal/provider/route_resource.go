@@ -4,16 +4,17 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
 	"github.com/netbirdio/terraform-provider-netbird/internal/provider/resource_route"
 	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
 )
 
 var _ resource.Resource = (*routeResource)(nil)
+var _ resource.ResourceWithImportState = (*routeResource)(nil)
 
 func NewRouteResource() resource.Resource {
 	return &routeResource{}
@@ -62,7 +63,13 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("failure to invoke create route API", err.Error())
 		return
 	}
-	createRoute, diags := toRouteModel(res.JSON200)
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	createRoute, diags := toRouteModel(ctx, res.JSON200)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -89,11 +96,15 @@ func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
-	route, diags := toRouteModel(res.JSON200)
+	route, diags := toRouteModel(ctx, res.JSON200)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -122,14 +133,14 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	route, diags := toRouteModel(res.JSON200)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, state.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	route, diags := toRouteModel(ctx, res.JSON200)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
@@ -152,56 +163,51 @@ func (r *routeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 
 }
 
-func toRouteModel(data *sdk.Route) (resource_route.RouteModel, diag.Diagnostics) {
+func (r *routeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func toRouteModel(ctx context.Context, data *sdk.Route) (resource_route.RouteModel, diag.Diagnostics) {
 	model := resource_route.RouteModel{
 		Description: types.StringValue(data.Description),
 		Enabled:     types.BoolValue(data.Enabled),
+		Id:          types.StringValue(data.Id),
 		KeepRoute:   types.BoolValue(data.KeepRoute),
 		Masquerade:  types.BoolValue(data.Masquerade),
 		Metric:      types.Int64Value(int64(data.Metric)),
-		Network:     types.StringValue(*data.Network),
+		Network:     types.StringPointerValue(data.Network),
 		NetworkId:   types.StringValue(data.NetworkId),
-		Peer:        types.StringValue(*data.Peer),
+		Peer:        types.StringPointerValue(data.Peer),
+		Domains:     types.ListNull(types.StringType),
+		PeerGroups:  types.ListNull(types.StringType),
 	}
 
-	var groups basetypes.ListValue
-	var peerGroups basetypes.ListValue
-	var domains basetypes.ListValue
 	var diags diag.Diagnostics
+	var d diag.Diagnostics
 
-	if data.Groups != nil {
-		_groups := make([]attr.Value, len(data.Groups))
-		for i, v := range data.Groups {
-			_groups[i] = types.StringValue(v)
-		}
-		groups, diags = basetypes.NewListValue(basetypes.StringType{}, _groups)
+	groups := data.Groups
+	if groups == nil {
+		groups = []string{}
 	}
+	model.Groups, d = types.ListValueFrom(ctx, types.StringType, groups)
+	diags.Append(d...)
+
 	if data.Domains != nil {
-		_domains := make([]attr.Value, len(*data.Domains))
-		for i, v := range *data.Domains {
-			_domains[i] = types.StringValue(v)
-		}
-		domains, diags = basetypes.NewListValue(basetypes.StringType{}, _domains)
+		model.Domains, d = types.ListValueFrom(ctx, types.StringType, *data.Domains)
+		diags.Append(d...)
 	}
 	if data.PeerGroups != nil {
-		_peerGroups := make([]attr.Value, len(*data.PeerGroups))
-		for i, v := range data.Groups {
-			_peerGroups[i] = types.StringValue(v)
-		}
-		groups, diags = basetypes.NewListValue(basetypes.StringType{}, _peerGroups)
+		model.PeerGroups, d = types.ListValueFrom(ctx, types.StringType, *data.PeerGroups)
+		diags.Append(d...)
 	}
 
-	model.Groups = groups
-	model.Domains = domains
-	model.PeerGroups = peerGroups
-
 	return model, diags
 }
 
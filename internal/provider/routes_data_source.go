@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*routesDataSource)(nil)
+
+func NewRoutesDataSource() datasource.DataSource {
+	return &routesDataSource{}
+}
+
+type routesDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type routesDataSourceModel struct {
+	Domain          types.String           `tfsdk:"domain"`
+	Enabled         types.Bool             `tfsdk:"enabled"`
+	Group           types.String           `tfsdk:"group"`
+	Ids             types.List             `tfsdk:"ids"`
+	NetworkContains types.String           `tfsdk:"network_contains"`
+	NetworkOverlaps types.String           `tfsdk:"network_overlaps"`
+	Routes          []routeDataSourceModel `tfsdk:"routes"`
+}
+
+func (d *routesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routes"
+}
+
+func (d *routesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists routes, optionally filtered. All filters that are set must match for a route to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return domain routes resolving this domain",
+				MarkdownDescription: "Only return domain routes resolving this domain",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only return routes with this status",
+				MarkdownDescription: "Only return routes with this status",
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return routes distributed to this group ID",
+				MarkdownDescription: "Only return routes distributed to this group ID",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the routes matching the filters",
+				MarkdownDescription: "IDs of the routes matching the filters",
+			},
+			"network_contains": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return routes whose network contains this IP address or CIDR range",
+				MarkdownDescription: "Only return routes whose network contains this IP address or CIDR range",
+			},
+			"network_overlaps": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return routes whose network overlaps this CIDR range",
+				MarkdownDescription: "Only return routes whose network overlaps this CIDR range",
+			},
+			"routes": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Routes matching the filters",
+				MarkdownDescription: "Routes matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: routeDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *routesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *routesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data routesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var contains, overlaps *netip.Prefix
+	if !data.NetworkContains.IsNull() {
+		prefix, err := parsePrefixOrAddr(data.NetworkContains.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid network_contains", err.Error())
+			return
+		}
+		contains = &prefix
+	}
+	if !data.NetworkOverlaps.IsNull() {
+		prefix, err := netip.ParsePrefix(data.NetworkOverlaps.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid network_overlaps", err.Error())
+			return
+		}
+		overlaps = &prefix
+	}
+
+	res, err := d.client.GetApiRoutesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list routes API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	data.Routes = []routeDataSourceModel{}
+	routeIds := []string{}
+	if res.JSON200 != nil {
+		for _, route := range *res.JSON200 {
+			if !data.Enabled.IsNull() && route.Enabled != data.Enabled.ValueBool() {
+				continue
+			}
+			if !data.Group.IsNull() && !slices.Contains(route.Groups, data.Group.ValueString()) {
+				continue
+			}
+			if !data.Domain.IsNull() && !routeHasDomain(route, data.Domain.ValueString()) {
+				continue
+			}
+			if contains != nil || overlaps != nil {
+				if route.Network == nil || *route.Network == "" {
+					continue
+				}
+				network, err := netip.ParsePrefix(*route.Network)
+				if err != nil {
+					continue
+				}
+				network = network.Masked()
+				if contains != nil && (contains.Bits() < network.Bits() || !network.Contains(contains.Addr())) {
+					continue
+				}
+				if overlaps != nil && !network.Overlaps(*overlaps) {
+					continue
+				}
+			}
+
+			model, diags := toRouteDataSourceModel(ctx, &route)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			data.Routes = append(data.Routes, model)
+			routeIds = append(routeIds, route.Id)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, routeIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parsePrefixOrAddr parses a CIDR range, treating a bare IP address as a
+// single host range.
+func parsePrefixOrAddr(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func routeHasDomain(route sdk.Route, domain string) bool {
+	if route.Domains == nil {
+		return false
+	}
+	for _, d := range *route.Domains {
+		if strings.EqualFold(d, domain) {
+			return true
+		}
+	}
+	return false
+}
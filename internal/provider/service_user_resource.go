@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*serviceUserResource)(nil)
+var _ resource.ResourceWithImportState = (*serviceUserResource)(nil)
+
+func NewServiceUserResource() resource.Resource {
+	return &serviceUserResource{}
+}
+
+type serviceUserResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type serviceUserResourceModel struct {
+	AutoGroups types.List   `tfsdk:"auto_groups"`
+	Id         types.String `tfsdk:"id"`
+	IsBlocked  types.Bool   `tfsdk:"is_blocked"`
+	Name       types.String `tfsdk:"name"`
+	Role       types.String `tfsdk:"role"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (r *serviceUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_user"
+}
+
+func (r *serviceUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description:         "Group IDs to auto-assign to peers registered by this user",
+				MarkdownDescription: "Group IDs to auto-assign to peers registered by this user",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User ID",
+				MarkdownDescription: "User ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_blocked": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "If set to true then user is blocked and can't use the system",
+				MarkdownDescription: "If set to true then user is blocked and can't use the system",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Service user name",
+				MarkdownDescription: "Service user name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				Description:         "User's NetBird account role",
+				MarkdownDescription: "User's NetBird account role",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User's status",
+				MarkdownDescription: "User's status",
+				PlanModifiers: []planmodifier.String{
+					userStatusPlanModifier{},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *serviceUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data serviceUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostApiUsersWithResponse(ctx, toCreateServiceUserApiRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke create service user API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	user := res.JSON200
+
+	// Users can't be created blocked, block them right after creation.
+	if data.IsBlocked.ValueBool() {
+		var diags diag.Diagnostics
+		user, diags = updateUser(ctx, r.client, user.Id, toUserApiRequest(data.AutoGroups, data.IsBlocked, data.Role))
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	serviceUser, diags := toServiceUserModel(ctx, user)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &serviceUser)...)
+}
+
+func (r *serviceUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serviceUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := getUser(ctx, r.client, data.Id.ValueString(), true)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	serviceUser, diags := toServiceUserModel(ctx, user)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &serviceUser)...)
+}
+
+func (r *serviceUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state serviceUserResourceModel
+	var plan serviceUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := updateUser(ctx, r.client, state.Id.ValueString(), toUserApiRequest(plan.AutoGroups, plan.IsBlocked, plan.Role))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	serviceUser, diags := toServiceUserModel(ctx, user)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &serviceUser)...)
+}
+
+func (r *serviceUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serviceUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteUser(ctx, r.client, data.Id.ValueString())...)
+}
+
+func (r *serviceUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func toCreateServiceUserApiRequest(data serviceUserResourceModel) sdk.UserCreateRequest {
+	return sdk.UserCreateRequest{
+		AutoGroups:    toStringSlice(data.AutoGroups),
+		IsServiceUser: true,
+		Name:          data.Name.ValueStringPointer(),
+		Role:          data.Role.ValueString(),
+	}
+}
+
+func toServiceUserModel(ctx context.Context, data *sdk.User) (serviceUserResourceModel, diag.Diagnostics) {
+	model := serviceUserResourceModel{
+		Id:        types.StringValue(data.Id),
+		IsBlocked: types.BoolValue(data.IsBlocked),
+		Name:      types.StringValue(data.Name),
+		Role:      types.StringValue(data.Role),
+		Status:    types.StringValue(string(data.Status)),
+	}
+
+	autoGroups := data.AutoGroups
+	if autoGroups == nil {
+		autoGroups = []string{}
+	}
+
+	var diags diag.Diagnostics
+	model.AutoGroups, diags = types.ListValueFrom(ctx, types.StringType, autoGroups)
+
+	return model, diags
+}
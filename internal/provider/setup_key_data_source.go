@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*setupKeyDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*setupKeyDataSource)(nil)
+
+func NewSetupKeyDataSource() datasource.DataSource {
+	return &setupKeyDataSource{}
+}
+
+type setupKeyDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type setupKeyDataSourceModel struct {
+	AutoGroups types.List   `tfsdk:"auto_groups"`
+	Ephemeral  types.Bool   `tfsdk:"ephemeral"`
+	Expires    types.String `tfsdk:"expires"`
+	Id         types.String `tfsdk:"id"`
+	IncludeKey types.Bool   `tfsdk:"include_key"`
+	Key        types.String `tfsdk:"key"`
+	LastUsed   types.String `tfsdk:"last_used"`
+	Name       types.String `tfsdk:"name"`
+	Revoked    types.Bool   `tfsdk:"revoked"`
+	State      types.String `tfsdk:"state"`
+	Type       types.String `tfsdk:"type"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	UsageLimit types.Int64  `tfsdk:"usage_limit"`
+	UsedTimes  types.Int64  `tfsdk:"used_times"`
+	Valid      types.Bool   `tfsdk:"valid"`
+}
+
+// setupKeyDataModel is the nested form of setupKeyDataSourceModel, without
+// the per-lookup include_key switch.
+type setupKeyDataModel struct {
+	AutoGroups types.List   `tfsdk:"auto_groups"`
+	Ephemeral  types.Bool   `tfsdk:"ephemeral"`
+	Expires    types.String `tfsdk:"expires"`
+	Id         types.String `tfsdk:"id"`
+	Key        types.String `tfsdk:"key"`
+	LastUsed   types.String `tfsdk:"last_used"`
+	Name       types.String `tfsdk:"name"`
+	Revoked    types.Bool   `tfsdk:"revoked"`
+	State      types.String `tfsdk:"state"`
+	Type       types.String `tfsdk:"type"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	UsageLimit types.Int64  `tfsdk:"usage_limit"`
+	UsedTimes  types.Int64  `tfsdk:"used_times"`
+	Valid      types.Bool   `tfsdk:"valid"`
+}
+
+func (d *setupKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setup_key"
+}
+
+func (d *setupKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := setupKeyDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Setup Key ID",
+		MarkdownDescription: "Setup Key ID",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "Setup key name identifier",
+		MarkdownDescription: "Setup key name identifier",
+	}
+	attributes["include_key"] = schema.BoolAttribute{
+		Optional:            true,
+		Description:         "Expose the setup key value in key. Defaults to false",
+		MarkdownDescription: "Expose the setup key value in `key`. Defaults to `false`",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a setup key by ID or by name. Exactly one of `id` or `name` must be set.",
+		Attributes:  attributes,
+	}
+}
+
+// setupKeyDataSourceAttributes returns the computed attributes describing a
+// setup key, shared by the netbird_setup_key and netbird_setup_keys data
+// sources.
+func setupKeyDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"auto_groups": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "List of group IDs to auto-assign to peers registered with this key",
+			MarkdownDescription: "List of group IDs to auto-assign to peers registered with this key",
+		},
+		"ephemeral": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Indicate that the peer will be ephemeral or not",
+			MarkdownDescription: "Indicate that the peer will be ephemeral or not",
+		},
+		"expires": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Setup Key expiration date",
+			MarkdownDescription: "Setup Key expiration date",
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Setup Key ID",
+			MarkdownDescription: "Setup Key ID",
+		},
+		"key": schema.StringAttribute{
+			Computed:            true,
+			Sensitive:           true,
+			Description:         "Setup Key value, only set when include_key is true",
+			MarkdownDescription: "Setup Key value, only set when `include_key` is `true`",
+		},
+		"last_used": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Setup key last usage date",
+			MarkdownDescription: "Setup key last usage date",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Setup key name identifier",
+			MarkdownDescription: "Setup key name identifier",
+		},
+		"revoked": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Setup key revocation status",
+			MarkdownDescription: "Setup key revocation status",
+		},
+		"state": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Setup key status, \"valid\", \"overused\",\"expired\" or \"revoked\"",
+			MarkdownDescription: "Setup key status, \"valid\", \"overused\",\"expired\" or \"revoked\"",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Setup key type, one-off for single time usage and reusable",
+			MarkdownDescription: "Setup key type, one-off for single time usage and reusable",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Setup key last update date",
+			MarkdownDescription: "Setup key last update date",
+		},
+		"usage_limit": schema.Int64Attribute{
+			Computed:            true,
+			Description:         "A number of times this key can be used. The value of 0 indicates the unlimited usage.",
+			MarkdownDescription: "A number of times this key can be used. The value of 0 indicates the unlimited usage.",
+		},
+		"used_times": schema.Int64Attribute{
+			Computed:            true,
+			Description:         "Usage count of setup key",
+			MarkdownDescription: "Usage count of setup key",
+		},
+		"valid": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Setup key validity status",
+			MarkdownDescription: "Setup key validity status",
+		},
+	}
+}
+
+func (d *setupKeyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *setupKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *setupKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data setupKeyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var setupKey *sdk.SetupKey
+	if !data.Id.IsNull() {
+		res, err := d.client.GetApiSetupKeysKeyIdWithResponse(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failure to invoke get setup key API", err.Error())
+			return
+		}
+
+		if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(err))
+			return
+		}
+		setupKey = res.JSON200
+	} else {
+		var diags diag.Diagnostics
+		setupKey, diags = findSetupKeyByName(ctx, d.client, data.Name.ValueString())
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	model, diags := toSetupKeyDataModel(ctx, setupKey, data.IncludeKey.ValueBool())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data = setupKeyDataSourceModel{
+		AutoGroups: model.AutoGroups,
+		Ephemeral:  model.Ephemeral,
+		Expires:    model.Expires,
+		Id:         model.Id,
+		IncludeKey: data.IncludeKey,
+		Key:        model.Key,
+		LastUsed:   model.LastUsed,
+		Name:       model.Name,
+		Revoked:    model.Revoked,
+		State:      model.State,
+		Type:       model.Type,
+		UpdatedAt:  model.UpdatedAt,
+		UsageLimit: model.UsageLimit,
+		UsedTimes:  model.UsedTimes,
+		Valid:      model.Valid,
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findSetupKeyByName returns the only setup key with the given name, failing
+// when no key or more than one key matches.
+func findSetupKeyByName(ctx context.Context, client *sdk.ClientWithResponses, name string) (*sdk.SetupKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.GetApiSetupKeysWithResponse(ctx)
+	if err != nil {
+		diags.AddError("failure to invoke list setup keys API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	var matches []sdk.SetupKey
+	if res.JSON200 != nil {
+		for _, setupKey := range *res.JSON200 {
+			if setupKey.Name == name {
+				matches = append(matches, setupKey)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("setup key not found", fmt.Sprintf("No setup key named %q exists.", name))
+		return nil, diags
+	case 1:
+		return &matches[0], diags
+	default:
+		ids := make([]string, len(matches))
+		for i, setupKey := range matches {
+			ids[i] = setupKey.Id
+		}
+		diags.AddError("multiple setup keys found", fmt.Sprintf("%d setup keys are named %q (IDs: %v), look the setup key up by id instead.", len(matches), name, ids))
+		return nil, diags
+	}
+}
+
+// toSetupKeyDataModel converts a setup key, leaving the key value null
+// unless includeKey is set.
+func toSetupKeyDataModel(ctx context.Context, data *sdk.SetupKey, includeKey bool) (setupKeyDataModel, diag.Diagnostics) {
+	model := setupKeyDataModel{
+		Ephemeral:  types.BoolValue(data.Ephemeral),
+		Expires:    types.StringValue(data.Expires.Format(time.RFC3339)),
+		Id:         types.StringValue(data.Id),
+		Key:        types.StringNull(),
+		LastUsed:   types.StringValue(data.LastUsed.Format(time.RFC3339)),
+		Name:       types.StringValue(data.Name),
+		Revoked:    types.BoolValue(data.Revoked),
+		State:      types.StringValue(data.State),
+		Type:       types.StringValue(data.Type),
+		UpdatedAt:  types.StringValue(data.UpdatedAt.Format(time.RFC3339)),
+		UsageLimit: types.Int64Value(int64(data.UsageLimit)),
+		UsedTimes:  types.Int64Value(int64(data.UsedTimes)),
+		Valid:      types.BoolValue(data.Valid),
+	}
+
+	if includeKey {
+		model.Key = types.StringValue(data.Key)
+	}
+
+	autoGroups := data.AutoGroups
+	if autoGroups == nil {
+		autoGroups = []string{}
+	}
+
+	var diags diag.Diagnostics
+	model.AutoGroups, diags = types.ListValueFrom(ctx, types.StringType, autoGroups)
+
+	return model, diags
+}
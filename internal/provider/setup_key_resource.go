@@ -3,16 +3,25 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
 	"github.com/netbirdio/terraform-provider-netbird/internal/provider/resource_setup_key"
 	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
 )
 
 var _ resource.Resource = (*setupKeyResource)(nil)
+var _ resource.ResourceWithImportState = (*setupKeyResource)(nil)
+
+// setupKeyImportedKey is the private state key marking setup keys brought in
+// through import. The API only returns the key value on creation, so those
+// never have one.
+const setupKeyImportedKey = "imported"
 
 func NewSetupKeyResource() resource.Resource {
 	return &setupKeyResource{}
@@ -60,8 +69,8 @@ func (r *setupKeyResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 
@@ -89,8 +98,12 @@ func (r *setupKeyResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, data.Id.ValueString()); err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 
@@ -101,6 +114,23 @@ func (r *setupKeyResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 	setupKey.ExpiresIn = data.ExpiresIn
 
+	// Imported setup keys have no expiration time in seconds yet, and the API
+	// returns neither it nor the creation date. Estimate it from the last
+	// update, which only matches the creation for keys that were never
+	// revoked or edited, rounded to whole days and kept within the range
+	// accepted by the schema.
+	if data.ExpiresIn.IsNull() {
+		days := math.Round(res.JSON200.Expires.Sub(res.JSON200.UpdatedAt).Hours() / 24)
+		days = min(max(days, 1), 365)
+		setupKey.ExpiresIn = types.Int64Value(int64(days) * 86400)
+	}
+
+	imported, diags := req.Private.GetKey(ctx, setupKeyImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if imported != nil {
+		setupKey.Key = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &setupKey)...)
 }
 
@@ -124,8 +154,8 @@ func (r *setupKeyResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, state.Id.ValueString()); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
 		return
 	}
 
@@ -136,6 +166,12 @@ func (r *setupKeyResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 	setupKey.ExpiresIn = plan.ExpiresIn
 
+	imported, diags := req.Private.GetKey(ctx, setupKeyImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if imported != nil {
+		setupKey.Key = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &setupKey)...)
 }
 
@@ -159,10 +195,45 @@ func (r *setupKeyResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	if res.StatusCode() != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %d", res.StatusCode()), string(res.Body))
+	if err := apierror.Check(res.HTTPResponse, res.Body, data.Id.ValueString()); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+}
+
+// ImportState accepts a setup key ID or the name of a setup key.
+func (r *setupKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	res, err := r.client.GetApiSetupKeysWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list setup keys API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	var setupKeys []sdk.SetupKey
+	if res.JSON200 != nil {
+		setupKeys = *res.JSON200
+	}
+
+	id, diags := resolveImportId("setup key", setupKeys, req.ID,
+		func(setupKey sdk.SetupKey) string { return setupKey.Id },
+		func(setupKey sdk.SetupKey) string { return setupKey.Name },
+	)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, setupKeyImportedKey, []byte("true"))...)
+	resp.Diagnostics.AddWarning(
+		"Setup key value unavailable",
+		"The API only returns the value of a setup key when it is created, the key attribute of an imported setup key is left empty.",
+	)
 }
 
 func toCreateSetupKeyApiRequest(data resource_setup_key.SetupKeyModel) sdk.CreateSetupKeyRequest {
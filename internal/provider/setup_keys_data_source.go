@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*setupKeysDataSource)(nil)
+
+func NewSetupKeysDataSource() datasource.DataSource {
+	return &setupKeysDataSource{}
+}
+
+type setupKeysDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type setupKeysDataSourceModel struct {
+	AutoGroups types.List          `tfsdk:"auto_groups"`
+	Ids        types.List          `tfsdk:"ids"`
+	IncludeKey types.Bool          `tfsdk:"include_key"`
+	Name       types.String        `tfsdk:"name"`
+	SetupKeys  []setupKeyDataModel `tfsdk:"setup_keys"`
+	State      types.String        `tfsdk:"state"`
+	Type       types.String        `tfsdk:"type"`
+}
+
+func (d *setupKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setup_keys"
+}
+
+func (d *setupKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists setup keys, optionally filtered. All filters that are set must match for a setup key to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"auto_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Only return setup keys auto-assigning all of these group IDs",
+				MarkdownDescription: "Only return setup keys auto-assigning all of these group IDs",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the setup keys matching the filters",
+				MarkdownDescription: "IDs of the setup keys matching the filters",
+			},
+			"include_key": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Expose the setup key values in setup_keys. Defaults to false",
+				MarkdownDescription: "Expose the setup key values in `setup_keys`. Defaults to `false`",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return setup keys with this name",
+				MarkdownDescription: "Only return setup keys with this name",
+			},
+			"setup_keys": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Setup keys matching the filters",
+				MarkdownDescription: "Setup keys matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: setupKeyDataSourceAttributes(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return setup keys in this state (valid, overused, expired, revoked)",
+				MarkdownDescription: "Only return setup keys in this state (`valid`, `overused`, `expired`, `revoked`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("valid", "overused", "expired", "revoked"),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return setup keys of this type (one-off, reusable)",
+				MarkdownDescription: "Only return setup keys of this type (`one-off`, `reusable`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("one-off", "reusable"),
+				},
+			},
+		},
+	}
+}
+
+func (d *setupKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *setupKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data setupKeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetApiSetupKeysWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list setup keys API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	autoGroups := toStringSlice(data.AutoGroups)
+
+	data.SetupKeys = []setupKeyDataModel{}
+	setupKeyIds := []string{}
+	if res.JSON200 != nil {
+		for _, setupKey := range *res.JSON200 {
+			if !data.Name.IsNull() && setupKey.Name != data.Name.ValueString() {
+				continue
+			}
+			if !data.State.IsNull() && setupKey.State != data.State.ValueString() {
+				continue
+			}
+			if !data.Type.IsNull() && setupKey.Type != data.Type.ValueString() {
+				continue
+			}
+			if !containsAll(setupKey.AutoGroups, autoGroups) {
+				continue
+			}
+
+			model, diags := toSetupKeyDataModel(ctx, &setupKey, data.IncludeKey.ValueBool())
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			data.SetupKeys = append(data.SetupKeys, model)
+			setupKeyIds = append(setupKeyIds, setupKey.Id)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, setupKeyIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// containsAll reports whether every element of want is in have.
+func containsAll(have []string, want []string) bool {
+	for _, v := range want {
+		if !slices.Contains(have, v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*userDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*userDataSource)(nil)
+
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type userDataSourceModel struct {
+	AutoGroups    types.List   `tfsdk:"auto_groups"`
+	DashboardView types.String `tfsdk:"dashboard_view"`
+	Email         types.String `tfsdk:"email"`
+	Id            types.String `tfsdk:"id"`
+	IsBlocked     types.Bool   `tfsdk:"is_blocked"`
+	IsCurrent     types.Bool   `tfsdk:"is_current"`
+	IsServiceUser types.Bool   `tfsdk:"is_service_user"`
+	Issued        types.String `tfsdk:"issued"`
+	LastLogin     types.String `tfsdk:"last_login"`
+	Name          types.String `tfsdk:"name"`
+	Role          types.String `tfsdk:"role"`
+	Status        types.String `tfsdk:"status"`
+}
+
+func (d *userDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "User ID",
+		MarkdownDescription: "User ID",
+	}
+	attributes["email"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "User's email address, matched ignoring case",
+		MarkdownDescription: "User's email address, matched ignoring case",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a user or service user by ID or by email. Exactly one of `id` or `email` must be set.",
+		Attributes:  attributes,
+	}
+}
+
+// userDataSourceAttributes returns the computed attributes describing a user,
+// shared by the netbird_user and netbird_users data sources.
+func userDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"auto_groups": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "Group IDs to auto-assign to peers registered by this user",
+			MarkdownDescription: "Group IDs to auto-assign to peers registered by this user",
+		},
+		"dashboard_view": schema.StringAttribute{
+			Computed:            true,
+			Description:         "User's permission to view the dashboard",
+			MarkdownDescription: "User's permission to view the dashboard",
+		},
+		"email": schema.StringAttribute{
+			Computed:            true,
+			Description:         "User's email address",
+			MarkdownDescription: "User's email address",
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "User ID",
+			MarkdownDescription: "User ID",
+		},
+		"is_blocked": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Is true if this user is blocked. Blocked users can't use the system",
+			MarkdownDescription: "Is true if this user is blocked. Blocked users can't use the system",
+		},
+		"is_current": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Is true if authenticated user is the same as this user",
+			MarkdownDescription: "Is true if authenticated user is the same as this user",
+		},
+		"is_service_user": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Is true if this user is a service user",
+			MarkdownDescription: "Is true if this user is a service user",
+		},
+		"issued": schema.StringAttribute{
+			Computed:            true,
+			Description:         "How user was issued by API or Integration",
+			MarkdownDescription: "How user was issued by API or Integration",
+		},
+		"last_login": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Last time this user performed a login to the dashboard",
+			MarkdownDescription: "Last time this user performed a login to the dashboard",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			Description:         "User's name from idp provider",
+			MarkdownDescription: "User's name from idp provider",
+		},
+		"role": schema.StringAttribute{
+			Computed:            true,
+			Description:         "User's NetBird account role",
+			MarkdownDescription: "User's NetBird account role",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			Description:         "User's status",
+			MarkdownDescription: "User's status",
+		},
+	}
+}
+
+func (d *userDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+func (d *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no endpoint returning a single user, list regular and service
+	// users alike.
+	res, err := d.client.GetApiUsersWithResponse(ctx, &sdk.GetApiUsersParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list users API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	var matches []sdk.User
+	if res.JSON200 != nil {
+		for _, user := range *res.JSON200 {
+			if !data.Id.IsNull() && user.Id == data.Id.ValueString() {
+				matches = append(matches, user)
+			}
+			if !data.Email.IsNull() && strings.EqualFold(user.Email, data.Email.ValueString()) {
+				matches = append(matches, user)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if !data.Id.IsNull() {
+			resp.Diagnostics.AddError("user not found", fmt.Sprintf("No user with ID %q exists.", data.Id.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("user not found", fmt.Sprintf("No user with email %q exists.", data.Email.ValueString()))
+		}
+		return
+	case 1:
+	default:
+		ids := make([]string, len(matches))
+		for i, user := range matches {
+			ids[i] = user.Id
+		}
+		resp.Diagnostics.AddError("multiple users found", fmt.Sprintf("%d users have email %q (IDs: %v), look the user up by id instead.", len(matches), data.Email.ValueString(), ids))
+		return
+	}
+
+	data, diags := toUserDataSourceModel(ctx, &matches[0])
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toUserDataSourceModel(ctx context.Context, data *sdk.User) (userDataSourceModel, diag.Diagnostics) {
+	issued := ""
+	if data.Issued != nil {
+		issued = *data.Issued
+	}
+
+	model := userDataSourceModel{
+		DashboardView: types.StringValue(""),
+		Email:         types.StringValue(data.Email),
+		Id:            types.StringValue(data.Id),
+		IsBlocked:     types.BoolValue(data.IsBlocked),
+		IsCurrent:     types.BoolValue(data.IsCurrent != nil && *data.IsCurrent),
+		IsServiceUser: types.BoolValue(data.IsServiceUser != nil && *data.IsServiceUser),
+		Issued:        types.StringValue(issued),
+		LastLogin:     types.StringValue(""),
+		Name:          types.StringValue(data.Name),
+		Role:          types.StringValue(data.Role),
+		Status:        types.StringValue(string(data.Status)),
+	}
+
+	if data.LastLogin != nil {
+		model.LastLogin = types.StringValue(data.LastLogin.Format(time.RFC3339))
+	}
+	if data.Permissions != nil && data.Permissions.DashboardView != nil {
+		model.DashboardView = types.StringValue(string(*data.Permissions.DashboardView))
+	}
+
+	autoGroups := data.AutoGroups
+	if autoGroups == nil {
+		autoGroups = []string{}
+	}
+
+	var diags diag.Diagnostics
+	model.AutoGroups, diags = types.ListValueFrom(ctx, types.StringType, autoGroups)
+
+	return model, diags
+}
@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ resource.Resource = (*userResource)(nil)
+var _ resource.ResourceWithImportState = (*userResource)(nil)
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResource struct {
+	client *sdk.ClientWithResponses
+}
+
+type userResourceModel struct {
+	AutoGroups    types.List   `tfsdk:"auto_groups"`
+	Email         types.String `tfsdk:"email"`
+	Id            types.String `tfsdk:"id"`
+	InviteTrigger types.String `tfsdk:"invite_trigger"`
+	IsBlocked     types.Bool   `tfsdk:"is_blocked"`
+	Issued        types.String `tfsdk:"issued"`
+	Name          types.String `tfsdk:"name"`
+	Role          types.String `tfsdk:"role"`
+	Status        types.String `tfsdk:"status"`
+}
+
+func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description:         "Group IDs to auto-assign to peers registered by this user",
+				MarkdownDescription: "Group IDs to auto-assign to peers registered by this user",
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				Description:         "User's Email to send invite to",
+				MarkdownDescription: "User's Email to send invite to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User ID",
+				MarkdownDescription: "User ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invite_trigger": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Arbitrary value, changing it resends the invitation to the user",
+				MarkdownDescription: "Arbitrary value, changing it resends the invitation to the user",
+			},
+			"is_blocked": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "If set to true then user is blocked and can't use the system",
+				MarkdownDescription: "If set to true then user is blocked and can't use the system",
+			},
+			"issued": schema.StringAttribute{
+				Computed:            true,
+				Description:         "How user was issued by API or Integration",
+				MarkdownDescription: "How user was issued by API or Integration",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "User's full name",
+				MarkdownDescription: "User's full name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				Description:         "User's NetBird account role",
+				MarkdownDescription: "User's NetBird account role",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User's status",
+				MarkdownDescription: "User's status",
+				PlanModifiers: []planmodifier.String{
+					userStatusPlanModifier{},
+				},
+			},
+		},
+	}
+}
+
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data userResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostApiUsersWithResponse(ctx, toCreateUserApiRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke create user API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	user := res.JSON200
+
+	// Users can't be created blocked, block them right after creation.
+	if data.IsBlocked.ValueBool() {
+		var diags diag.Diagnostics
+		user, diags = updateUser(ctx, r.client, user.Id, toUserApiRequest(data.AutoGroups, data.IsBlocked, data.Role))
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	createdUser, diags := toUserModel(ctx, user)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	createdUser.InviteTrigger = data.InviteTrigger
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &createdUser)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data userResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := getUser(ctx, r.client, data.Id.ValueString(), false)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readUser, diags := toUserModel(ctx, user)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	readUser.InviteTrigger = data.InviteTrigger
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &readUser)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state userResourceModel
+	var plan userResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := updateUser(ctx, r.client, state.Id.ValueString(), toUserApiRequest(plan.AutoGroups, plan.IsBlocked, plan.Role))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !plan.InviteTrigger.IsNull() && !plan.InviteTrigger.Equal(state.InviteTrigger) {
+		res, err := r.client.PostApiUsersUserIdInviteWithResponse(ctx, state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failure to invoke resend user invitation API", err.Error())
+			return
+		}
+
+		if err := apierror.Check(res.HTTPResponse, res.Body, state.Id.ValueString()); err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(err))
+			return
+		}
+	}
+
+	updatedUser, diags := toUserModel(ctx, user)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	updatedUser.InviteTrigger = plan.InviteTrigger
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedUser)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data userResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteUser(ctx, r.client, data.Id.ValueString())...)
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getUser looks up a user by ID. The API has no endpoint to fetch a single
+// user, so the list of users of the requested kind is searched instead. A nil
+// user without errors is returned when no user has the given ID.
+func getUser(ctx context.Context, client *sdk.ClientWithResponses, id string, serviceUser bool) (*sdk.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.GetApiUsersWithResponse(ctx, &sdk.GetApiUsersParams{ServiceUser: &serviceUser})
+	if err != nil {
+		diags.AddError("failure to invoke get users API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	if res.JSON200 != nil {
+		for _, user := range *res.JSON200 {
+			if user.Id == id {
+				return &user, diags
+			}
+		}
+	}
+
+	return nil, diags
+}
+
+func updateUser(ctx context.Context, client *sdk.ClientWithResponses, id string, request sdk.UserRequest) (*sdk.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.PutApiUsersUserIdWithResponse(ctx, id, request)
+	if err != nil {
+		diags.AddError("failure to invoke update user API", err.Error())
+		return nil, diags
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, id); err != nil {
+		diags.Append(apierror.Diagnostic(err))
+		return nil, diags
+	}
+
+	return res.JSON200, diags
+}
+
+func deleteUser(ctx context.Context, client *sdk.ClientWithResponses, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := client.DeleteApiUsersUserIdWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError("failure to invoke delete user API", err.Error())
+		return diags
+	}
+
+	if err := apierror.Check(res.HTTPResponse, res.Body, id); err != nil && !apierror.IsNotFound(err) {
+		diags.Append(apierror.Diagnostic(err))
+	}
+	return diags
+}
+
+func toCreateUserApiRequest(data userResourceModel) sdk.UserCreateRequest {
+	var name *string
+	if !data.Name.IsUnknown() && !data.Name.IsNull() {
+		name = data.Name.ValueStringPointer()
+	}
+
+	return sdk.UserCreateRequest{
+		AutoGroups:    toStringSlice(data.AutoGroups),
+		Email:         data.Email.ValueStringPointer(),
+		IsServiceUser: false,
+		Name:          name,
+		Role:          data.Role.ValueString(),
+	}
+}
+
+func toUserApiRequest(autoGroups types.List, isBlocked types.Bool, role types.String) sdk.UserRequest {
+	return sdk.UserRequest{
+		AutoGroups: toStringSlice(autoGroups),
+		IsBlocked:  isBlocked.ValueBool(),
+		Role:       role.ValueString(),
+	}
+}
+
+func toUserModel(ctx context.Context, data *sdk.User) (userResourceModel, diag.Diagnostics) {
+	issued := ""
+	if data.Issued != nil {
+		issued = *data.Issued
+	}
+
+	model := userResourceModel{
+		Email:     types.StringValue(data.Email),
+		Id:        types.StringValue(data.Id),
+		IsBlocked: types.BoolValue(data.IsBlocked),
+		Issued:    types.StringValue(issued),
+		Name:      types.StringValue(data.Name),
+		Role:      types.StringValue(data.Role),
+		Status:    types.StringValue(string(data.Status)),
+	}
+
+	autoGroups := data.AutoGroups
+	if autoGroups == nil {
+		autoGroups = []string{}
+	}
+
+	var diags diag.Diagnostics
+	model.AutoGroups, diags = types.ListValueFrom(ctx, types.StringType, autoGroups)
+
+	return model, diags
+}
+
+// userStatusPlanModifier keeps the status of a user from state as long as
+// is_blocked doesn't change. Blocking or unblocking a user changes its status,
+// so it's left unknown then.
+type userStatusPlanModifier struct{}
+
+func (m userStatusPlanModifier) Description(ctx context.Context) string {
+	return "The status doesn't change unless is_blocked changes."
+}
+
+func (m userStatusPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "The status doesn't change unless `is_blocked` changes."
+}
+
+func (m userStatusPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.Plan.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var stateBlocked, planBlocked types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_blocked"), &stateBlocked)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_blocked"), &planBlocked)...)
+	if resp.Diagnostics.HasError() || !planBlocked.Equal(stateBlocked) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/netbirdio/terraform-provider-netbird/internal/apierror"
+	"github.com/netbirdio/terraform-provider-netbird/internal/sdk"
+)
+
+var _ datasource.DataSource = (*usersDataSource)(nil)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+type usersDataSourceModel struct {
+	EmailDomain types.String          `tfsdk:"email_domain"`
+	Ids         types.List            `tfsdk:"ids"`
+	IsBlocked   types.Bool            `tfsdk:"is_blocked"`
+	Role        types.String          `tfsdk:"role"`
+	ServiceUser types.Bool            `tfsdk:"service_user"`
+	Users       []userDataSourceModel `tfsdk:"users"`
+}
+
+func (d *usersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists users, optionally filtered. All filters that are set must match for a user to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"email_domain": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return users whose email address belongs to this domain, e.g. example.com",
+				MarkdownDescription: "Only return users whose email address belongs to this domain, e.g. `example.com`",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the users matching the filters",
+				MarkdownDescription: "IDs of the users matching the filters",
+			},
+			"is_blocked": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only return users with this blocked status",
+				MarkdownDescription: "Only return users with this blocked status",
+			},
+			"role": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return users with this account role",
+				MarkdownDescription: "Only return users with this account role",
+			},
+			"service_user": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only return service users when true, or regular users when false",
+				MarkdownDescription: "Only return service users when `true`, or regular users when `false`",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Users matching the filters",
+				MarkdownDescription: "Users matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data usersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &sdk.GetApiUsersParams{}
+	if !data.ServiceUser.IsNull() {
+		params.ServiceUser = data.ServiceUser.ValueBoolPointer()
+	}
+
+	res, err := d.client.GetApiUsersWithResponse(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("failure to invoke list users API", err.Error())
+		return
+	}
+
+	if err := apierror.CheckJSON(res.HTTPResponse, res.Body, res.JSON200, ""); err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(err))
+		return
+	}
+
+	emailSuffix := "@" + strings.ToLower(strings.TrimPrefix(data.EmailDomain.ValueString(), "@"))
+
+	data.Users = []userDataSourceModel{}
+	userIds := []string{}
+	if res.JSON200 != nil {
+		for _, user := range *res.JSON200 {
+			if !data.IsBlocked.IsNull() && user.IsBlocked != data.IsBlocked.ValueBool() {
+				continue
+			}
+			if !data.Role.IsNull() && user.Role != data.Role.ValueString() {
+				continue
+			}
+			if !data.EmailDomain.IsNull() && !strings.HasSuffix(strings.ToLower(user.Email), emailSuffix) {
+				continue
+			}
+
+			model, diags := toUserDataSourceModel(ctx, &user)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			data.Users = append(data.Users, model)
+			userIds = append(userIds, user.Id)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Ids, diags = types.ListValueFrom(ctx, types.StringType, userIds)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
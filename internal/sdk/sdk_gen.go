@@ -1427,7 +1427,7 @@ type ClientInterface interface {
 	GetApiLocationsCountries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetApiLocationsCountriesCountryCities request
-	GetApiLocationsCountriesCountryCities(ctx context.Context, country Country, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetApiLocationsCountriesCountryCities(ctx context.Context, country CountryCode, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetApiPeers request
 	GetApiPeers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1826,7 +1826,7 @@ func (c *Client) GetApiLocationsCountries(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiLocationsCountriesCountryCities(ctx context.Context, country Country, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetApiLocationsCountriesCountryCities(ctx context.Context, country CountryCode, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetApiLocationsCountriesCountryCitiesRequest(c.Server, country)
 	if err != nil {
 		return nil, err
@@ -2960,7 +2960,7 @@ func NewGetApiLocationsCountriesRequest(server string) (*http.Request, error) {
 }
 
 // NewGetApiLocationsCountriesCountryCitiesRequest generates requests for GetApiLocationsCountriesCountryCities
-func NewGetApiLocationsCountriesCountryCitiesRequest(server string, country Country) (*http.Request, error) {
+func NewGetApiLocationsCountriesCountryCitiesRequest(server string, country CountryCode) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4303,7 +4303,7 @@ type ClientWithResponsesInterface interface {
 	GetApiLocationsCountriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiLocationsCountriesResponse, error)
 
 	// GetApiLocationsCountriesCountryCitiesWithResponse request
-	GetApiLocationsCountriesCountryCitiesWithResponse(ctx context.Context, country Country, reqEditors ...RequestEditorFn) (*GetApiLocationsCountriesCountryCitiesResponse, error)
+	GetApiLocationsCountriesCountryCitiesWithResponse(ctx context.Context, country CountryCode, reqEditors ...RequestEditorFn) (*GetApiLocationsCountriesCountryCitiesResponse, error)
 
 	// GetApiPeersWithResponse request
 	GetApiPeersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiPeersResponse, error)
@@ -5718,7 +5718,7 @@ func (c *ClientWithResponses) GetApiLocationsCountriesWithResponse(ctx context.C
 }
 
 // GetApiLocationsCountriesCountryCitiesWithResponse request returning *GetApiLocationsCountriesCountryCitiesResponse
-func (c *ClientWithResponses) GetApiLocationsCountriesCountryCitiesWithResponse(ctx context.Context, country Country, reqEditors ...RequestEditorFn) (*GetApiLocationsCountriesCountryCitiesResponse, error) {
+func (c *ClientWithResponses) GetApiLocationsCountriesCountryCitiesWithResponse(ctx context.Context, country CountryCode, reqEditors ...RequestEditorFn) (*GetApiLocationsCountriesCountryCitiesResponse, error) {
 	rsp, err := c.GetApiLocationsCountriesCountryCities(ctx, country, reqEditors...)
 	if err != nil {
 		return nil, err
@@ -7313,155 +7313,154 @@ func ParseGetApiUsersUserIdTokensTokenIdResponse(rsp *http.Response) (*GetApiUse
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x92XLctvrnq6B6pipJVat3rVejWLajLLLGss+ZmtjVQZPobkRsgAFAyX08qpqbeYh5",
-	"nXmUeZJ/YSNBEty0RT7/Ti6sJkHgA/D7VnwAvvYCuokpQUTw3snXHkM8poQj9WMBwzlDfyWIC/kzRDxg",
-	"OBaYkt5J70cYgvfm5V2/t6RsgcMQkXLJN+mru34PE4EYgdEcMUZZufC5eQ+uELtBDLxWxe76PUkIZojP",
-	"YSLWiAgcQP1JsYb3piAoFLy76/d4sEYbqDp3GgSIc7yI0CVCihAYRe+WvZPfv/b+K0PL3knvvwyzwRma",
-	"T4ey9G+Y4E2y6d31v/ZiRmPEBNZDFhI+j+ACRWW65IffcXB2cQVUCYA5EGsEYsg4CkGMEAMEbhBYUgZC",
-	"uoGYAIY4jRJZwQCcC/lFIssKKgttACTgzX8/uwCLLYBxjEiIyUrVCYOAJkR8x21Fguq2ZCOq9QFAg9VA",
-	"PdgLCd/TDwkSC8zCQRDRJOz1e+gL3MQR6p30uIArtLemXOyNS8XENtZlGCYrNctxZf/PLwEMQ4Y4z9U/",
-	"Hg0OZoPRYOyrLuGIzXFYrvMjRwycnwG6VN2T5YBYQwEQYTSK5FCtMVe9zLW2onQVoT0qITL5X5PDw9nh",
-	"7PB4cnh0MDsYjQ4Oj6eHZToyEIa9k99lH/vOfGdUfk6/pIs/USB6d5/v+hJvckpkH/KQ8fXLFAbnZzmy",
-	"g/URniWrg4iQ2ep4/dfNYTTyjRdHQmCyUtXXYdk0c2WLl7ooJzetrNyvtFevvwgGr5xW812U4z+Hcczo",
-	"jeR8AhcR8vT6+1cST4CSaPsDeK1KcSCZAXP9t4KvrQesIrqAUbQdgPMlMJX2AYwiVY5LmKEQ3OIoAgsE",
-	"MAGWQ7iAAoGECByZ2lCoeIgAGG4wGbiDLliC0o4vKI0Q1LKkaizeZxIzPwqPNSttJqR6LpCcqpYk5Kf1",
-	"rt9bMZrEfC5rhCslVqtn8zSK6C0HtixSHErQreZSmAgKdHVSOOkZU6y7QBElK25FlizdPB/93p+3Yg5l",
-	"k3Nda5mgXzEXUlJkrd6ucbBWLXAAGQLqexRK8anlExZoY0bN8uCpRAjmgkFBGfdxn3kAGYNbS5kZuCCC",
-	"eDOXUr5M3oWU/UaQqXJgyejG0HiLgJq4QFjyZSWqEzAMARbqLyM1dIkcinuMRshLrUNd01QWKFDk/fzP",
-	"D4ZaSMJ2tFTNoJISEV1hMkdfYswq9PslYpiGaqTwBgG4FIiZUdLqTdYAVA2Ig+85CigJ+Q85EmbTyWiU",
-	"0iBtkhVilTRUD0ylkHKIUFU4wupUERxrbajLrSE3BIeZHpMPBZUlpOz63rFm0A8DcBrHEZYtk2hbYKBb",
-	"JKGshJ8Uarq275XhBQOBbxC4unqnW/6h1cQwtEoiyOaKUeY3GN3OFxENrmugot5LWWu+NUymICMrkK9i",
-	"yAS3iOdbLtCmneR1hWH9hFWBqrZPPrH6ao2C6xqZokuaCYgRU+aZtsREAiMQ6O/7BWG8QnQeUW2gzlWZ",
-	"Jsn8FtFfzQeKJkkcWcxvEOOt67j48R+6eFoD5d1qeHdVrEGNM0HilrLrOYNkhdrVJK3CC/3Ze/lVViGj",
-	"Ugi3rEUXNh/7lPMrLLbl6TtTvxYIBFhswQrRFYPxGgcwAnZaACZyMi1u8vMnP6sQ56/oZqOYU1nsr8kq",
-	"wnytDXwr5CVJroz+EbEIE5+QXiEqv/TawOdaejlmMEMBZaGUGm8RvVB6IoQCLiBHbnuT4/3ReP+oLAYL",
-	"LOY03nd6/LlilC+efjheSdXCaudTl7jPlOov5wENURPsDB2vZNG7fvrpvQFhuuUOwlvENpBsG72RXNv9",
-	"fC+8M+WQXiJ1shchIbXU+dU7MB0fHOyNAYziNdybAFmjFnMMxQxxOSCV1J+99k4fQ1CgKySS+Be0rbSX",
-	"pYnYzpgD52faFEoE3YOc4xXJdCJDK8wFYsoXEGvtEV6jrd+8K7tYm1EbIw/Fa7RBDEY+Bg2V1tajlrrh",
-	"1jFJv5RWBKGijUY21s0ce+yj15nRoQwkTICxgdyajw5m0gLawC8qknEyHe9PD0bqkQlu2CJlI8mPbzWf",
-	"4Be0BQaDDgzQEiaRMKNeMZb++q7RFsj3fUAJ2qPLpQqPcExWEdL9SzhcIWV9MpQoGyxv+GYPPXEFuELz",
-	"CG+wJ8B1CkiyWSBmLU2eIgcEkMiZk4w8AB/WCNzAKFFsPALYzLZmioSo2lGoyczZN6NGyWsGUpXKTXo/",
-	"xxz5jvi4/eziqtojNJZrON9AAldog4ioZLu32vS/XVOOVCgr+wZgbo3g8DF5qzAmNdT6ev76BvmCLsoQ",
-	"9poEH7TRpt5qjqVBkDApPsKE2QAbUtW6OHtPE4FAoESbNyZmK001S7ldXViLWKMTOpBCJNP+rsJQAylh",
-	"BiGKkEAmMjX4kyrgqL8xucHZG1UYhqH9rYaz/CBfnXQoB0kcwuyRMqDtj4Tkfqo2lB2uQ0pJfI22bsvp",
-	"s8LPtIn0CUM39Dr3hN4glvDcowLJhee6DZZEyP0zLa1+pQ3HNMKBpcv8SIua32lht379d/oqJHxgYjcD",
-	"i+JBhuIcae3KplQYb3ug4ec8sHVkwz/I3KCMtHbFDSGty2svTI6n5A070urvbKjVz5QQFdVQkf/ccJQe",
-	"p9+X3mTTJmnifO2QnT5KKVNPGDKCtqnbDd0svtbAYzc4QAPFBOn0xIhxSmA00JGmgaDXiGSvcx9lUPN+",
-	"ZF5/zgd7svEux+Z9cQwpQ0BC8F8JAjhEROAlzsfdxiNfXQQLDAVlc7SBOPJLNbQn39movxVs6af2gZJj",
-	"A/B6sBr0AST2M7q0IQxtPjG8WiEbKNHf5ORwiDb0v9kFCkx7tVT7xkKSnPlSjWTqop1IzK8+jCfT2f7B",
-	"4dHxyPmrnmy/ASYJd92JCtJzpPxM1wScUa91tEFCBYphGGLZBIwuczq09EGZHFmFdD1zFLgEfDXGZG+z",
-	"BQq1kqXdKIKzNDQaTmY2oiMnrhesYbAIqLQnFuQgWO3z43HP5/wLyFZItJhtXbB+qlMDXk22mXiGNvQG",
-	"hYPCcg0Mj8MjSV+wf7waH9HEb+9I61LATewnT8ozZd8qc/d2jUhGXWoR5BqejCbTvdH+3mj/w3h0Mpqd",
-	"TA8Hs8Pp/mzyP3tqqXYDhWQVKNCerLR5rUtJkpTMzJjpFe2aAneVUFuWGe70GND5DLlS4KscGKZcJAzp",
-	"WJtyE1aIpk5/ydGXdPviy6eB9p0oEPAagSSmBGgtDzZQBGvH1FLrBVJbI7LNS2D7pjTXlpw6l9ah2lmm",
-	"ULjTdEAd/HWt7LowhR23Rts6I65vh8c7FVLRtl8xV8Wrl8yVl149GtqJN4237HB+jb6+z7p5/5ptjvJW",
-	"C7dvTTiiYdnW7/dgzhNfTP0neqtmX8c6biEHuiT4Hsa4D5T3qG2RPvjzVvzgIjTGPZ10sUpD33/eigJY",
-	"VaESOX4to3uoFqv8hkIvRDfelSY10vN0FbwYHUuIM9+Qcxpg6UrZdUDV+Vz4stF9xtZ07OUbr8R0ZSiq",
-	"bijIfYeiCfM45K396HFnP7oyhptKi+ro6gMD5bUhVRtBvuvfPxRbERutDIb+hskviBEU5VY1GhSMCSYi",
-	"cK0+BWYBpdT1DSZzXcSusZRrNjJGLTzHQroTTnXZxB8MDgbjSaO29rRY0esu/ZUKVXbXVKlWvWIkpQpZ",
-	"meU7b98f2unxbDBt1eW6vhaWvP6tu5p6xB6VFfvzDnR5cH6ZI+dooP736gY+90dtndo+mLCljUuFcV7t",
-	"yAc+2UiZqK33UhZwKtqfNmsC2ZKl2TRRP3YeG6dZ+ztEru5vCJS1mIfUhhXefD/SPM3P5T5W6rxc1/yq",
-	"QP2yPhEpdD6/jKVcXhUx1mW8alEnK3oU42/S8La5jBHmQqVD8jVNolCtoWxisdW5EHgJYoY3kG1VgiVL",
-	"VNDdo0XNX4OASmbewC+/IrIS697JZH9frYLY3+M2yz9VGSIlSHABRcLbLPJUxeDPsKRjkei8kmz9S7qj",
-	"IVpigrh5nJoSOi8ER5H0VvU6hme6HnE5rD7Fqdh2ecUow0t+bmajxqnJKue10yFh1NahcGTqXY6eaQU5",
-	"6UAYLPo4SM8UXgJYHhDMUxSbtVZOoxvEVXqjYZMCD0gYpSxgiqhOysoUg7RK9uEIsmA9NxVUpz5dqXKW",
-	"JzWqleLcOLzaiUZCRWs6/QtlLoV5IGQcmvJVNjeZ4Knsvk9ZFJJgHsWmcAJjkISMai2Tsy1646mSlZDd",
-	"6uXfwtvZYDJQYTBMuef14WCqX0eYJF+qDNTe/mA6UO3cYhLS20pLtjceDUaD8WQ6U5NSCLFkXajjrqIh",
-	"mutdxw9Npzt+lY5Fw3ceLyE/RPf43hew7L4l4UfIkSe4AtNdDvMGnzMrqXVGW9FY2EfR5HaWCPJHXtIu",
-	"PdK+jDT5PKOlNvv8PF3Fv10jsTYJm4AgFPI0A72NOL2n40sICoSPyksVeabgt2zd3ZSWQqW9cZF9NK/Z",
-	"rBEniwgHbgsV2zem+zXbNx6QUrXbT9OY//exuGqns2wleaX0PzUWEPAYBXiJA38gJ6d+Jwez6eFs6ksD",
-	"asgRyWc7ZVn9bQVLIWxcsqzk2PnNzJ/MG+uZbGCwxgRVjv8z7GBqCgCZqosWQTm25CzyTAeTgdcYjyAX",
-	"OufZI+4hF3ohKd0ZZfOVUehLNseU/GDXwOzOjTQRvbDg5aw7jY5PRqOT6f5gdnh4eNR63ckQzxGqpV2R",
-	"nUpJyY0XmoW+425GkllHr1sb2z+ZHAxmk9H+4VEHGltvEKjQI6XtAWvIwQIhYvcxeXIBlzDiXlHuUNOe",
-	"hHTvgd130KFBytsDGJLQi94zZeCB8VRZq949bAzDaK5z8Dzuh65elzKZerkGXo0m/+Pn0c+jn9/+dOit",
-	"n6+7TNvV1U8mcqHcKTtLxL/BsErrJrhRBISIXwsag4/n3nEbDcYzP9e/jE2S/V5jByHaSP+HgSDCnfpY",
-	"DOunppVrMRVNm4L5kd+ymUttT/3CVLP0deCwILxzAtYVWDWCocilionyIMxmMBvDHGL6Hiu2yCd11rQI",
-	"1k/iU1Qt6V2k6bMe3yKL3jYGbyvaq+5qpxVbZVCX4rSlxJJR+xVSg3MHRW3Njsr1y88VPmJ550yLKITK",
-	"TVBb1hDZmrkB0jpU8szoJ2kQmkQca+0g/kJyKFRiUMPyaUq8LiytmlfnZ++ljrMrlOVw5++98fFkMD44",
-	"GoxttpEKb4wGo+FRr9+bjEbjk3BxdDKeTGcnYzganZwM9w+c6Wm5+Gp6UJtlIee3em/E3+XP1pk+zZaD",
-	"ZZj2dnhBTd8rLJiXspU98E+CzrnUUY4P9FrbpYXFbZ30PYceIXhmdz6rbE2VvJHliHus0smH8exkdnQy",
-	"GQ1mB/uT0XF7q9SSsdi2swFu19TSklH4GBaAM7YqF7dpUMzGhkozfXYyPTqZHA2ODw8PxgftB8S7W86k",
-	"a5Y7G6yP8P7MXeog1d5Vwn0s55lsWVoFGfy9m30YT05m+yeT/cHx4WTaqXvN28jLnfxtC5aYSTfKvGqr",
-	"gcqzmgNc32WCloz0FhHpLOiBLOaE6dJzrZ3mwjJevclS5tW7fi+OICZZDQXtGKloDvribGeD7qrmyhLp",
-	"Gcx6pq0fWZeqfkV/W45jpZJouUsMExDCbY79prmtYQf7zq6wcfsdYY8KRReFuk/e4VFGRgczV5Wvjhuz",
-	"JPJZGvozIN+a1ERlWRkT5+M5QCEWlLXOV1TfvU8i5At0cZqwAM1jbcjNg4qt6DlDj4Pzs++4SRRVARJD",
-	"m64MpL6Od80XBuG1Slte0BuM1+Fh921aeuCqiK+w3XOT0S0hwczIkmFEwmgL8kuBWec+SFcXcwBBaDYl",
-	"moHR2dz69ILMg+RggcQtQkQtvOrN1bpL3gSGyoCCIa99fB5XV9KcVLLq4KzoOuuSGNs10GZFttbSynig",
-	"I/fKb2pO5EJcYFKVc+0yslMyS6t4rGi1BU0tBS5zPl7jxeODUvjmhqaOJd0R9m6lrOFI1TGd96Z8TkZj",
-	"DmIYXCPBXTdQlZBEMVrIE0tflSC9wCFmmlF9G691igXA5lQESQg2MjFQOXiYgAUVa5DWwvtmDnhfRS7d",
-	"EWqVPdFGRilC7iGo1HfPIqY0GB9DVqmankJgqYrrpJbZc16VYdjAiXC51EsLqqwELhY2lFBMHHISJlvl",
-	"RsWMChrQqJ4C+VVqPDG4XOIgHzWRTQVxr2/yKHGwKfCNftvOqkq94zxLOcTWxypSMfExti7fixThlSg8",
-	"bJvZ9mBBXscIx4edDa17iPP7zdHLMZIN/f9epnLVhClK09huIfCU9q82pUKXatZOuUBxK/1kBhA7uyIx",
-	"BywhRJ/9pTsJLpD4US/OZmsZraI1eZoeWY+4VZutz/dSKDUxk0p3Jz+zGUP+G89vm2l4jPH3eB7e8ddH",
-	"hlXvctLZRGZBW2rD7CyOWyzWmABoV9Kzw+vy86fSCucxFGtPx6FYp2lEmhaAvqAg0dtDlljbpxD8Kiup",
-	"zRXtDRPOhmrNZrjAZGjSibx7umHwUHp+g0EDNafawlaKaGiwMYBxPHxFiVDQ/Q0G767q6DQplQ+l9Z+6",
-	"mnp6X518SiajyTGjKwY3Z1DA//d/NNGfbGIW+uLfI12Fqoa1uFfpWtwCE8gwkuRjLvQZmgylHIZJynj/",
-	"/3//X16198iMgVnkbKVMDfobd8emNft4SJ1503WTjD4o5zwsrbgWFKlflpuzASo2H5k1P2U7m4OY1DAu",
-	"pfmunSmTCKiIUMlw55c3s+H55c1BegBBRpZ811Lgu4R13q+jxiS3Syf3pFsoTA9wlVxPw662t+134Zxl",
-	"+28k9y0QCLcEbnAAo2hrtyiEA/CKkmWEA8H1HkkzNH/nLhw9JA/fevM23W0TUCIg1mqQJgplpbTph9qJ",
-	"/d41QvGcWS6rOFbOxjd0H9O9FtcoFuZ03BT2IUWcfJduJ9HRhPNLAMl2QxlqMzQbyP9KEINhA0nKWDDU",
-	"ZN9YV1ZLb2lLSKq/4yBmaIm/tCIACYaDqjnWb01C2AD8Sm8RF/YktzXkYI1XavmbYcoKJ04eHx8fO+se",
-	"5mf9wofBdqUoUjGDNPtAL+z1ixySbT8pp5OaPIRKSVgtYm0GRGZP9YE9dBv8dKqHnnsOMRt33nMVI1+O",
-	"nk6tyfKSnU39qtv6mCKgLE0j3/TZeoQKiWCOpJxZ6XwF9cUf6vAXzZ1/NKXsjKt23leebnepNssZJn90",
-	"uv+olg1tKK/V00oHFbY/ZQBxAzyGfXKM7OS+ORLHp/HtoZ270zq7n9ZZd2SmkwpsFteLy8wHe6OxWmY+",
-	"PJlMBpPj8Wj/8GGpEFnrecd6sj8d7x9NpwcTbyL7tq4mdRBmjvrTg/HB6Pjw9d6bV29Ge7OjN6d7x9P9",
-	"2d6r09npwXg8mxwejDumWWQng5rsCrhCVcP2sGT0upNOr9G2jb9adeipPsWwtneyiDlbo2y6VOZYqdst",
-	"6qrVdfXBp94NjHD4qSf/NEcoyl+fDGDDTz0J8k+W1E+9XP/Uxy/rOFcVRvHnYRUxo4o+DWhe3KmyKrM4",
-	"nKvmfKlhcsADe/4OtwPVcNZO3wCgZqRVASzaL3v7lJqmJJ/9kZ2Ja0FouSnXVVeIWL4onZybYSY/c66S",
-	"qNOEu+Or/7MfX/1kknx3Lnb3c7EzQdDthOyP3HdeTi0Pv+3Cu4utHiZzndFjsq7/5FXZoe84QO7Zq50P",
-	"Sq3eTfQYecKYV98jc67PbtHBDTNuAJuLZSS8ftR/mItlAki+E+ZwE+QJ8lbyGOZzdYCmbwOLQ0Nu02NK",
-	"jGpLmoCQ52a3IAAZguE7Em1rMij43OxVVBfStB8OaPc4lpo2PW7TdvWpgzpPPDtwcLEFp5fnUtKf544U",
-	"bDxLsMOWVNVmtiUVmn2KJtwfQr5eUMjCZ7L1DRvpjeSMbgAOY+n03+CwYO9/oBtwFayT6F/+uAPbYM5t",
-	"CkNdMFg2eekUl8KPRtW0mRWW9MotVTg3J+HGf4+K0UVVFWeqKk3SEvhGn+F6g/UWBufSJDdjy5RrETi3",
-	"h8AamW6IzwvvlBBHYlQJcX25yP2ssm9Cor9WEl1QwBEJgZ4Jva2+m2x/MpFTve3H259lEkVlm6uem56E",
-	"H4ppGT4kFketCoWXeXYvrN5YGabuHavsRyYy5GzLomUJaBjTGFMOP/Z7clzzbJmVarGSKYn4prmo1rpY",
-	"miCthrjU7UULQ1nO9zYr/k6AVktIta0+SBgW2yupbPRk/oggQ+w00SvtC/XrjVWZP//zQ8/cm6y6qN5m",
-	"pK2FiGV/1UYUW0XxjkSBmLsryp6b+of66A+z9NPXB6h80lUBsojnb6bLUTiQ/33qqUP8ZHVrBLXmNQfH",
-	"y0Ypw/+ypoiFQYx/Qdve3Z06OH9JPX7M5bkEwAYSHCeRdHD1IPZVxhrv6zQwjLjxoQTD6Aa5p9wCuKCJ",
-	"sCcWE2OLqpPShZpHO9HvX199kIaTs7P7pKfOzVLHKcSISLPppDcdjNW+9xiKtZqaIYzx0MDEXBgofDde",
-	"i4QRKZmj7DAn9Ul2NcEAnEa3cCt5r1iYkvSAGznKacbLeajuHxOnMT61JPTzN4RPRiN9Z5rK6zAbU23u",
-	"x/BPrpentWHTOjfB3pRcXvMo3TFwCn6+encB1Hu33/LbmabN11Lah6F7xbn6Ztz8TdVN5Or7afP3S/c+",
-	"9P02VBYuTXf5WCVduBz8++e7/leXH3//fPe53+PJRp9DqENPMIqAM6kCrrgUKemjz7KNHPqGX81f5+Gd",
-	"hqC6d8STPiWfc3WZspFvKqsligAWPMtLHwDplqRl6C0xfhzQNadzWcakbsKB5amlTPEOgxsk1Flnv/uu",
-	"UyjdcqKAk1Jr5YzKPEqlDHRayGSwdqkyeBfl9Wc/u/gGLB0Ic5JiYA7A2qHYj2I7asTC2I/ifi9OPBJT",
-	"J1x6ZHkOB3nUXSbiW4GcQsKPNNx2Es4tZHKaolQWxWZFxenV3QOVRSsd4dEJKSRAZs3u2MjPRh/TWatl",
-	"I6sMQsKHhfN121okUQScw3ffWnvVZ22cEX6RO7v16W2O4sHf7W2PU2t7lHu3w12zEeLDhAXg2cWVFuGU",
-	"+24MUYEeibFiHWXRTXkFrh5fUlYdvV4G0AW6LXcfOEB4MulZQrsP3WXS3u3EaQOsNSb9kMyjukKiDr8S",
-	"rtzQVlZ2G+ynxnIe/Re2mfvbL762PXYMcVp6GtN5ZzJ3Mpmbwdn3a/W3SPhM5nvq9W8UgX+fAN6Zs40Q",
-	"f2+jdK1AXuMaDt+jOIJBKwmrHcNvENx/p/FjHI8Sxl+s+bPjvtbOZDfrhzsX0zc4k/r6IF08uxKyQtWk",
-	"F94/h//oXrB/V+0sagtasrfsivliB6kWAt2d+m6ivC1wUjGeA87jy8gCVMpI8RH7lOKwkaAddB8gDZ3R",
-	"4pVyUN3l3DWcZj7yC8DX9uXTyz7V1H0iZobGHYaaw2TpdFr8mAcZhLJsjA4QWtW5a6kv9/QQunfQdRdp",
-	"bQ2hUnjVPGgTYV3VhVUdnDy+tmwTRH0+z6HGX9BE7EKkrUOkRc8ghWNeog2/doqHruqDoLqRtw/2zVfV",
-	"Dvku2vmyop2VOOsY5fQjy1WV3wqsnkcU7uIlHaKVNSBtF6Vc1YQmXzI6n99iMH7ZCzIadpzSOrLYaDTY",
-	"Swz5UF/7ZBLWKyW96w2Zi6IAJmCyFyEhEAPnV+/AdHxwsDcGMIrXcG+iNG+Vz/Srbf5V2vpj+U/OftTX",
-	"Lc4HKaHNbjm2vdTd2IGu2XPKj5gDPkRBOuH1GBx+NZXcDQMsmkCZd9Jfk1WE+RoEWOjNydxc47nCN4jk",
-	"qGuNSnvNqqalpBI8Et40UyvhW1zs+sRWySs503XgT8dwh/w2yM8gt9iCDAJNHJDeNd0hFmWPT/MB+BI9",
-	"VyJgdlXePaJRmswdsJqBZSfUAkn/LgBo+FX+09bzN/dXVjj+qoFLVd0DLGDThkc8xrbqndP/Epz+Sz1R",
-	"RXh1dPm9kHIk0jeBp0fRq/puf58EVIf87TyYDr5+JTY7blXxw1O7/C8Vno/v7rvXZNZsS9F9uduxyDfg",
-	"5FcwSGobmH25Xe1L+1mFQM9eP4OVqa+pu4+JacncAamFlZnNaQom+6jNsqe+CKRq3TOHmCcQbO5dLRUr",
-	"n/aKlWeIYlrIegWcJuNd+ZiBB6wPXtrB90xdURQMv+qpau0r+Cc28xZMvZem1ofoUNuUT4tm1e/chhfh",
-	"NtRgrqPvcNlO3XxDCHsuMbKzlLo4E7WIbbd2eFmh5pJvAqjPr3mNofqylO+Oa9r7F60sC3VVz152BVcn",
-	"V8O9X65SA2QXgD2X1+FcJncP36PQrR3U2nggzo1PeT8k96KdN+IMf7VTUkTVU8jH0tV1Ff5JjuRnkpQu",
-	"xn2YztP02D6LM6t1s+0XM9KNychv78zU4cLxaRxkXObaeZBOzzfuVe2Ftna+zsvwdVpiteuiSS0cPbrv",
-	"28bi3yK1dsZeJxepNdDb+UsNijj5phH+d5kLxjp/n0ToRRoKO5br4F91tILMTXTdnKz0+jqfgnlvXz69",
-	"V6UvQb2HO2Vo3CGq2Y1Kp9NCyTxo4za9N/ecet0lByePL/jyt7r6PSRNwLNIPANUHzD1vY+7zWutHT0L",
-	"qhIe8yJt+FX929aTY16oph6cbuS9rvEBVoRtxmM9sLTynZP2Epy0Spx1dMreNyvLbwVXzyMLd+ZeBw+r",
-	"BqXtPKoKHZ28dHQ+v81g3SQF0hdhNew4pbVj1Gg1qMs3967RtqszlN5eWCXj7XWVz+MTpddE38MtSu83",
-	"3LlGbVyj3MxbYDkP27hI7p2vXjcpj57Hl3qalOKVqhUuU3ZT53PIvwzKPuhmA73zntp7T+moVUG2LA+H",
-	"X6+RTe/rZPlWY7sgGH9BD8tucRvy2BjX6CWlYbVE9U65dzGDG2HdfYtHjWROvgH0Pr6maKEjzJYPQZ0J",
-	"udvx0rdkKLdWEPrGtW62sr2lzacNPpp3tWz0Bkfyjbl62NwOpxpCWKwRAwytkggyU4Cy3I2Z3HLYXwli",
-	"24zFcvdKejgruyX/83OY8OpC7HtcwaZHcAfyZsvdYs0CXP9uY68TdJuDlIYYCdWta+mNsADmgFhl21sy",
-	"nkJaly/k9YU01MWbmmpHBz6pzNbo9qFZUbMT1a1t+Y88t1nPgjgnoIdf5T8NqyAf1piDDRJrKuXpht4o",
-	"qCt4q4u3YRAgzjFZOXexDsAbyvQFshGC8gv57vzs0tzmSgQMBEhIhLh+9cfennyzp4nYkxXv4TD+A2AO",
-	"Ysg5Cs2doHCFNkhfx8dEEtdcQqh6/JE/cKutvf+2bHolfHeywAtakanAe3fT/iOv3L39UiH1NAqiSTUk",
-	"Jmdopw6+Acu9kzoYaq2v7hf3mjzvkbrkPkktBHvLc7Ulo9nmXFf8LcljTfJOHrcNu1QgoyXy1F3kXT1H",
-	"/ZE5fc1rUztepAbiB93OywbiYx+hxTglMDpVBpsagPsdplWqZudXtvErU8hZVjAPmjxL41jqW/prMF6W",
-	"ti8Q5E9x0EwJjzWWi6c0CPQwO4P0tOfRlCh4i4icSBT6KJbTo+ceExBHEBMg0Jed1dPGCdZCzsNxtdpn",
-	"+FX92zY7sJExfS6ppuSDbuelcGi/Q8PCDK2nZZH2aucavwTXuJIL+k1GFo9RgJc4aMZ4hYG1A/jznALo",
-	"sez8J56VbbddgkSXteRqjXKfFux1/b+XJd0SJpEAukSv30tY1DvprYWI+clQqq4BQWKBWTjAtCfrMgSV",
-	"vVeBGAwEuMVirdbEbjC69QS+lCYcZBi360X3rlDrUqfG1FW4d5Xq9FmnRnuu770rTFfX3VqdBc0HVK1v",
-	"yXGqTa/Eun/3zXEY7ghkx849oFr3+Ihc5bl9YQ9oQu+6cKpO91jdu8qziysQULLEq0SrIaf2s4srT9X/",
-	"qMDpGgEYqDO0VXsEiVvKrs01fk6t6bV43St2qzk1j3p3n+/+IwAA//+Xqxfl5wcBAA==",
+	"H4sIAAAAAAAC/+x963IbufLfq6AmqdrdKooiKer6KbJle7Vn11Ys+39SWbu44AxIYjUEZgGMZB5HVfmS",
+	"h8jr5FHyJCncZjAzmJtuK51w94PFwa0B/LrR3WgA34OQrhNKEBE8OPkeMMQTSjhSP+YwmjH0V4q4kD8j",
+	"xEOGE4EpCU6CVzACH03i7SBYUDbHUYRINefbLOl2EGAiECMwniHGKKtmPjfp4BKxa8TAG5XtdhBIQjBD",
+	"fAZTsUJE4BDqIuUaPpqMoJTx9nYQ8HCF1lB17jQMEed4HqMLhBQhMI4/LIKT378H/5mhRXAS/KfdfHB2",
+	"TdFdmfs3TPA6XQe3g+9BwmiCmMB6yCLCZzGco7hKlyz4Awdn7y+BygEwB2KFQAIZRxFIEGKAwDUCC8pA",
+	"RNcQE8AQp3EqKxiCcyFLpDKvoDLTGkAC3v7Xs/dgvgEwSRCJMFmqOmEY0pSIH7itSFDdlmxEtT4EaLgc",
+	"qg87EeE7+iNBYo5ZNAxjmkbBIEDf4DqJUXAScAGXaGdFudgZV7KJTaLzMEyWapaT2v6fXwAYRQxxXqh/",
+	"PBoeTIej4dhXXcoRm+GoWudnjhg4PwN0obon8wGxggIgwmgcy6FaYa56WWhtSekyRjtUQmTyPyaHh9PD",
+	"6eHx5PDoYHowGh0cHu8dVunIQRgFJ7/LPg6c+c6p/JqVpPM/USiC26+3g+BUT0lwUoaMr18mMzg/K5Ad",
+	"ro7wNF0exIRMl8erv64P45FvvDgSApOlqr4Jy6aZS5u90sUocCqr9ivr1ZtvgsFLp9ViF+X4z2CSMHot",
+	"OZ/AeYw8vf7xtcQToCTe/ATeqFwcSGbAXP+t4GvrAcuYzmEcb4bgfAFMpQMA41jl4xJmKAI3OI7BHAFM",
+	"gOUQLqBAICUCx6Y2FCkeIgBGa0yG7qALlqKs43NKYwS1LKkbi4+5xCyOwkPNSpcJqZ8LJKeqIwnFab0d",
+	"BEtG04TPZI1wqcRq/WyexjG94cDmRYpDCbrRXApTQYGuTgonPWOKdecopmTJrciSudvnYxD8eSNmUDY5",
+	"07VWCfoVcyElRd7qzQqHK9UCB5AhoMqjSIpPLZ+wQGszaqb54FQiBHPBoKCM+7jPfICMwY2lzAxcGEO8",
+	"nkkpXyXvvZT9RpCpfGDB6NrQeIOAmrhQWPJlJaoTMIoAFuovIzV0jgKKA0Zj5KXWoa5tKksUKPJ++ecn",
+	"Qy0kUTda6mZQSYmYLjGZoW8JZjXr+wVimEZqpPAaAbgQiJlR0subrAGoGhAHP3IUUhLxnwokTPcmo1FG",
+	"AyYCLRGrpaF+YGqFlEOEqsIRVqeK4ESvhjrfCnJDcJSvY/KjoDKHlF0/OtoM+mkITpMkxrJlEm9KDHSD",
+	"GDLCTwo1XduPspNy9vA1ApeXH3TLP3WaGIaWaQzZTDHK7Bqjm9k8puFVA1RUupS1pqxhMgUZWYFMSiAT",
+	"3CKeb7hA626S1xWGzRNWB6rGPvnE6usVCq8aZIrOaSYgQUypZ1oTEymMQajLD0rCeInoLKZaQZ2pPG2S",
+	"+R2iv5oCiiZJHJnPrhHjnet4/+o/dPasBsr71fDhslyDGmeCxA1lVzMGyRJ1q0lqhe91sY+yVF4hoyHi",
+	"vGMtOrMp7FucX2OxqU7fmfo1RyDEYgOWiC4ZTFY4hDGw0wIwkZNpcVOcP1msRpy/puu1Yk6lsb8hyxjz",
+	"lVbwrZCXJLky+hViMSY+Ib1EVJb06sDnWno5ajBDIWWRlBrvEH2v1okICjiHHLntTY73R+P9o6oYLLGY",
+	"0/jA6fHXmlF+//jD8VouLaxxPnWOu0ypLjkLaYTaYGfoeC2z3g6yoncGhOmWOwjvEFtDsmm1RgptD4q9",
+	"8M6UQ3qF1MlOjISQmLr8APbGBwc7YwDjZAV3JkDWqMUcQwlDXA5ILfVnb7zTxxAU6BKJNPkH2tTqy1JF",
+	"7KbMgfMzrQqlgu5AzvGS5GsiQ0vMBWLKFhArbRFeoY1fvauaWOtRFyUPJSu0RgzGPgaN1KqtRy0zw61h",
+	"kpWUWgShosuKbLSbGfboR29ypUMpSJgAowO5NR8dTKUGtIbflCfjZG+8v3cwUp+Mc8NmqSpJfnyr+QT/",
+	"QBtgMOjAAC1gGgsz6jVj6a/vCm2ATB8AStAOXSyUe4RjsoyR7l/K4RIp7ZOhVOlghbadjx6/AlyiWYzX",
+	"2OPgOgUkXc8Rs5omz5ADQkjkzElGHoJPKwSuYZwqNh4BbGZbM0VKVO0o0mQW9JtRq+Q1A6lyFSZ9UGCO",
+	"Ykd83H72/rLeIjSaazRbQwKXaI2IqGW7d+o7uFlRjpQrKy8DMLdKcPSQvFUakwZqfT1/c418ThelCHtV",
+	"gk9aaVOpmmNpGKZMio8oZdbBhlS1Ls4+0lQgECrR5vWJ2UqzlaXars6sRaxZE3qQQiTT/q7cUMMEITaM",
+	"UIwEMp6p4Z8UE/s3Jtc4T1GZYRTZ32o4qx+K1UmDcpgmEcw/KQXa/khJ4adqQ+nh2qWUJldo47acfSv9",
+	"zJrIvjB0Ta8KX+g1YikvfCqRXPqu22BpjNw/s9zqV9ZwQmMcWrrMjyyr+Z1lduvXf2dJEeFD47sZWhQP",
+	"cxQXSOuWN6PCWNtDDT/ng60jH/5hbgblpHXLbgjpnF9bYXI8JW/YkVZ/50OtfmaEKK+G8vwXhqPyOStf",
+	"ScmnTdLE+cohO/uEiPuFISNo27rd0s1ysgYeu8YhGiomyKYnQYxTAuOh9jQNBb1CJE8uFMqh5i1kkr8W",
+	"nT35eFd98z4/hpQhICX4rxQBHCEi8AIX/W7jka8uggWGgrIZWkMc+6Ua2pFp1utvBVtW1H5QcmwI3gyX",
+	"w4F0xZpidGFdGFp9Yni5RNZRosu4ZAYRWtP/YjcoMA0aqcaRn+Tclmol8/ysN4nF3YfxZG+6f3B4dDxy",
+	"/mom26+AScJdc6KG9AIpv9AVAWfUqx2tkVCOYhhFWDYB44vCGlopUCVHViFNzwIFLgHfjTIZrDdAoVay",
+	"tOtFcLaGRruTqfXoyIkLwhUM5yGV+sScHITLfX48DnzGv4BsiUSH2dYZm6c6U+DVZJuJZ2gtdxGGpe0a",
+	"GB1HR5K+cP94OT6iqV/fwWvEBVwnfvKkPFP6rcwHblaI5NRlGkGh4closrcz2t8Z7X8aj05G05O9w+H0",
+	"cG9/OvnvgdqqXUMhWQUKtCMrbd/rkvXnZObKTFDWa0rcVUFtVWa402NA51PkKo6vqmOYcpEypH1tykxY",
+	"IpoZ/RVDH4a6XHXvTdtOFAh4hUCaUAL0Kg/WUIQrR9VS+wVytUZkU5TANqUy15acJpPWodrZplC403RA",
+	"7fx1tewmN4Udt1bdOiduYIfHOxVyoe2+Y66y12+ZKyu9fjRUsvGvdu1wcY++uc+6ef+ebYHyThu374w7",
+	"Ihjcwe7BnKc+n/rP9EbNvvZ13EAOdE7wI0zwACjrUesiA/DnjfjJRWiCg0Hg5AjUjk8JrAn2keNfZXQP",
+	"1WaVX1EIInTt3WlSIz3LdsHL3rGUOPMNOachlqaU3QdUnS+4L1vNZ2xVx6DYeC2ma11RTUNB7joUbZjH",
+	"Ee9sR49729G1PtxMWtR7V+/pKG90qVoP8u3g7q7YGt9orTP0N0z+gRhBcWFXo2WBMc5EBK5UUWA2UCpd",
+	"X2My01nsHku1ZiNj1MZzIqQ54VSXT/zB8GA4nrSu1p4Wa3rdp79yQZXdNVWqXa8ESalClmb7ztv3+3Z6",
+	"PB3udepyU19LW17/1l3NLGLPkpX44w50fnB+USDnaKj+964NfOb32jq1fTJuS+uXipLisiM/+GQjZaKx",
+	"3guZwalof699JUiCnGbTRPPYeXSc9tXfIXJ5d0Wguop5SG3Z4S32I4vT/FrtY+2aV+iafylIhOEOYUxP",
+	"t/PFbSxl8iqPsc7jXRZ1sCL38I9UvG0sY4y5UOGQfEXTOFJ7KOtEbHQsBF6AhOE1ZBsVYMlSNPSvouav",
+	"YUglM6/ht18RWYpVcDLZ31e7IPb3uMv2T12ESAUSXECR8i6bPHU++DMs6ZinOq4k3/+S5miEFpggbj5n",
+	"qoRKUxtOKUd6H8MzXQ+4HdYc4lRuu7pjlOOlODfTUevU5JXzxumQMOpqUOTFgtsCPXs15GQDYbDo4yA9",
+	"U1g6jyoDgnmGYrPXyml8jbgKbzRsUuIBCaOMBUwW1UlZmWKQTsE+HEEWrmamgvrQp0uVz/KkRrVaONcO",
+	"r/aikVDRmU7/RplLYREIOYdmfJXPTS54arvvWyxKQTAPolM4jjFIIkb1KlPQLYLxnpKVkN1g4kmdDidD",
+	"5QbDlHuSD4d7OjnGJP1Wp6AG+8O9oWrnBpOI3tRqstI7NxpKz6WalJKLJe9CE3eVFdFC73oWNJ3uWSob",
+	"i5ZyHiuhOER3KO9zWPY/kvAKcuRxrsDslMOsxebMc+o1o6toLJ2jaDM7KwT5PS9Zlx7oXEYWfJ7T0hh9",
+	"fp7t4t+skFiZgE1AEIp4FoHeRZze0fAlBIXCR6XspnSJ/Jbvu5vcmFox3ImurNCs4bBGks5jHLot1Bzf",
+	"2NtvOL5xj5Cq7Xma1vi/z+VdOx1lK8mrhP+psYCAJyjECxz6HTmF5XdyMN07nO75woBaYkSK0U55VH9X",
+	"wVJyG1c0Kzl2fjXzZ5NiLZM1DFeYoNrxf4ITTG0OIFN1WSOo+pacTZ694WToVcZjyIWOefaIe8iF3kjK",
+	"TkbZeGUU+YLNMSU/2T0we3LDppU3vJx9p9HxyWh0src/nB4eHh513ncyxHOEGmlXZGdSUnLje81CP3A3",
+	"Isnsozftje2fTA6G08lo//CoB42dDwjUrCOV4wEy2H+OELHnmDyxgAsYc68od6jpTkJ29sCU69Mg5d0B",
+	"LPctfeg9UwoeGO8pbdV7ho1hGM90DJ7H/NDV61wmUq/QwOvR5L/9Mvpl9Mu7nw+99fNVn2m7vPzZeC6U",
+	"OWVnifgPGNatuiluFQER4leCJuDzuXfcRsPx1M/1z+OQ5CBo7SBEa2n/MBDGuFcfy279TLVyNaayalNS",
+	"P4pHNguh7ZldmK0sA+04LAnvgoB1BVaDYChzqWKiIgjzGczHsICYgUeLLfNJkzYtwtWj2BR1W3rvs/BZ",
+	"j22Re29bnbc17dV3tdeOrSxQ9dNWAktG3XdIDc4dFHVVO2r3L7/W2IjVkzMdvBAqNkEdWUNkY+YGSO1Q",
+	"yTOzPkmF0ATiWG0H8WcSQ6ECg1q2TzPidWap1bw+P/so1zi7Q1l1d/4ejI8nw/HB0XBso42Ue0PGHslt",
+	"kMloND6J5kcn0t9xMoaj0cnJ7v5B8NVDZKMVbHrQGGUh57f+bMTfZc82qT7tmoNlmO56eGmZvpNbsChl",
+	"a3vgnwQdc6m9HJ9kxGV1MkzQ9wx6hOCZPfmsojVV8EYeI+7RSiefxtOT6dHJZDScHuxPRsfdtVJLxnzT",
+	"TQe4WVFLS07hQ2gAzthKglsHReVHvFZNn57sHZ1MjobHh4cH44PuA+I9LWfCNaudlVsd+1N3q4PUW1fS",
+	"bdBpsmVu5WTw9276aTw5me6fTPaHx4eTvV7daz9GXu3kbxuwwIyLLKnrClSd1QLgBi4TdGSkd4ggBo2X",
+	"qxwTpnPP9Oo0E5bxmlWWShOy5SSGmOQ1lFbHWHlz0DfnOBt0dzWXlkjPYDYzbfPIulQNavrbcRxrF4mO",
+	"p8QwARHcFNhvr3A07GDfORU27n4i7EGh6KJQ98k7PErJ6KHmqvz1fmOWxj5NQxcDMtWEJirNyqg4n88B",
+	"irCgrHO8oir3MY2Rz9HFacpCNEu0IjcLa46iFxQ9Ds7PfuAmUFQ5SAxtujKQ2TrePV8YRlcqbHlOrzFe",
+	"RYf9j2npgasjvkZ3L0xGv4AEMyMLhhGJ4g1wU13UfZKmLuYAgsgcSjQDo6O59e0FuQUpvTLiBiEik8zh",
+	"at0lbwBDrUPBkNfdP4/rK2kPKln2MFZ0nU1BjN0a6LIj26hp5TzQk3tlmYYbuRAXmNTFXLuM7OTMwyoe",
+	"ylttQdNIgcucD9d4aWZy+BaGpokl3RH2HqWkpLljOu5N2ZyMJhwkMLxCgrtmoMohiWK0FCeWJVUgPccR",
+	"ZppRYVwXYiFDDRTjSkKwkYmhisHDBMypWIGsFj4wc8AHynPpjlCn6IkuMkoRcgdBpco9iZjSYHwIWaVq",
+	"egyBpSpuklrmzHldhGELJ8LFQm8tqLwSuFhYV0I5cMgJmOwUG5UwKmhI42YKZKlMeWJwscBh0WsimwqT",
+	"YGDiKHG4LvGNTu2mVWXWcZGlHGKbfRWZmPicWJPvWYrwWhQedo1su7cgb2KE48PeitYdxPnd5uj5KMmG",
+	"/n8vVbluwhSlmW+35HjK+tcYUqFzta9OBUdxp/XJDCB2TkViDlhKiL77S3dSbs6+0puz+V5GJ29NkaYH",
+	"Xkfcqs3R5zstKA0+k1pzpzizOUP+G89vl2l4iPH3WB7e8ddXhtWfctLRRGZDW66G+V0c8ugPJgDanfT8",
+	"8rri/KmwwlkCxcrTcShWWRiRpgWgbyhM9fGQBdb6KQS/ykoaY0WD3ZSzXbVnszvHZNeEE3nPdMPwvvT8",
+	"BsMWak61hi0r5rsGG0OYJLuvKREKur/B8MNlE50mpPK+tP5TV9NM7+uTL+lkNDlmMhZqfQYF/D//SxP9",
+	"xQZmoW/+M9J1qGrZi3ud7cXNMYEMI0k+5kLfoclQxmGYZIz3f//n/+Z1Z4/MGOgf3RZTXaL9dGxWs4+H",
+	"1J03fQ/JqELgPAoGzQupX5abuwFqDh/pVK07m4uY1DAupPqujSkTCKiIUMFw5xfX093zi+uD7AKCnCyZ",
+	"1lHgu4T1Pq+jxqRwSqfwpZ8rTA9wnVzP3K62t91P4Zzl528k980RiDYErmXwYLyxRxSiIXhNySLGoeD6",
+	"jKQZmr/zFI4ekvsfvXmXnbYJKREQ62WQpgpllbDp++qJg+AKoWTGLJfVXCtn/Ru6j9lZiyuUCHM7bgb7",
+	"iCJOfsiOk2hvgoxnJJs1ZajL0Kwh/ytFDEYtJCllwVCTl7GmrJbemGuqZaQxQwv8rRMBSDAc1s2xTjUB",
+	"YUPwK71BXJifKspuhZdq+5thyko3Th4fHx87+x7mZ/PGh8F2rShSPoMs+kBv7A3KHJIfP6mGk5o4hFpJ",
+	"WC9iTQ5HnxoAe+k2+PlUDz33XGI27n3mKkG+GD0dWpO17h7qV91WBMgL9LC+Jhwxoe/WI1RIBHMk5cxS",
+	"xyuoEn/Ihsx1b3+0heyM607e195uJwnmwDD5g9P9R71s6EJ54zqt1qDS8accIK6Dx7BPgZGd2DdH4vhW",
+	"fHtp5/a2zv63dTZdmemEApvN9fI288HOaKy2mQ9PJpPh5Hg82j+8XyhE3nrRsJ7s7433j/b2DibeQPZN",
+	"U03qIswC9acH44PR8eGbnbev3452pkdvT3eO9/anO69Pp6cH4/F0cngw7hlmkd8MaqIr4BLVDdv9gtGb",
+	"bjq9Qpsu9mrdpaf6FsPG3sks5m6NqupSG2MlszYSresagC/BNYxx9CWQf5orFOWvLwaw0ZdAgvyLJfVL",
+	"UOifKvy8rnNNIhuC0ooZlfVxQPPsbpWVJKFopprzhYbJAQ/t/TvcDlTLXTsDA4CGkVYZsLCI632qV8FL",
+	"U1KM/sjvxLUgtNxU6KorRCxfVG7OzTFTnDl3kWhaCbfXV///fn31o0ny7b3Y/e/FzgVBvxuyZYBsTx5+",
+	"14d35xs9TOY5o4dkXf/Nq7JDP3CA3LtXe1+UWn+a6CHihDGvf0fmXN/dop0bZtwANg/LSHi90n+oBC6R",
+	"94Mwl5sgj5O3lscwn6kLNIlopKFw6DEjRrUlVUDIC7NbEoAMwegDiTcNERR8Zs4qzlKOWCMpheGA9oxj",
+	"pWnT4y5t1986qOPE8wsH5xtwenEuJf154UrB1rsEexxJTXnhSCo05xSNuz+CfDWnkEVPpOsbNtIHyeW5",
+	"ahwl0ui/xlFxvINPdA0uw1Ua/8vvd2BrzLkNYWhyBssmL5zsUvjRuJ42s8OSPbmlMhfmRD5Z5iPKrEV1",
+	"FedLVRakJfC1vsP1GusjDM6jSU6DNl8Hx7m9BNbIdEN8UXhnhDgSo06I68dF7qaVvQiJ/kamSoo4IhHQ",
+	"M6GP1feT7Y8mcuqP/Xj7s0jjuKpzNXPTo/BDCZ1eJJZHrQ6FF0V2L+3eWBmm3h2r7UcuMuRsy6xVCWgY",
+	"0yhTDj8OAjmuRbbMc3XYyZREvGguatQuFsZJqyEu1/ayhqE05zurFX8nQOslpBT5KEwZFptLudjoyXyF",
+	"IEPsNNU77XP1661dMn/556fAvJusuqhSc9JWQiSyv+ogiq2i/EaiQMw9FWXvTf1DFfrDbP0M9AUqX3RV",
+	"gMyT2du9xSgayv++BOoSP1ndCkG98mqhEshGKcP/sqqIhUGCpZv6VvZZXkzrsWMuziUA1pDgJI2hsJFe",
+	"AxWxxgc6DAwjbmwowTC6Ru4ttwDOaSoMpmUmpYtKGrBQ82gn+uOby09ScXJOdstT7iN9ERdNEIEJDk6C",
+	"PXnuNBgEMupBTc0uTPCugYn6sETC9+K1SBmRkjnOL3NSRfKnCYbgNL6BG8l75cyUZBfcyFHOIl7OI8nM",
+	"SJwm+NSSMCi+ED4ZjQL1ZpqK6zAHU23sx+6fXG9Pa8Wmc2yCacyz51F5Y+AU/HL54T1Q6W6/ZdnpaFTX",
+	"UtaHXfeJc1Vm3F6m7iVyVX6vvfzCfQ99vwuVpUfTXT5WQRcuB//+VQYbOPz4+9fbr4OAp2t9D6F2Pckw",
+	"cWdSBVxyKVKyT19lGwX07X43f51HtxqCMfKe7VTfuXpMWRfQUS1xDLDgeVz6EEizJMtDb4ix44Cu2abw",
+	"KiZ1Ew4sTy1lincYXCOBGFdDU31OofLKiQJORq2VM5IHcykDnRZyGaxNqhzeZXn91c8uvgHLBsLcpBia",
+	"C7C2KPaj2I4asTD2o3gQJKlHYuqAS48sL+CgiLqLVLwUyCkkvKLRppdw7iCTsxClqig2OypOr27vuVh0",
+	"WiM8a0IGCZBrs1s28rPR52zWGtnILgYR4bul+3W7aiRxDJzLd99ZfdWnbZwR/t5p5Cl0jvLF3911j1Or",
+	"e1R7t8VduxLiw4QFoLz9WYlwyn0vhihHj8RYuY6q6Ka8BlcPLynrrl6vAug9uql2HzhAeDTpWUG7D91V",
+	"0j5sxWkLrDUm/ZAsorpGou5+J1yZoZ207C7Yz5TlIvrf22burr/42vboMcRp6XFU563K3EtlbgfnwL+q",
+	"v0PCpzLfcV1/oQj8+wTwVp1thfhH66XrBPIG03D3I0piGHaSsBfpCwX336n8GMOjgvFnq/5sua+zMdlP",
+	"++HOw/QtxqR+Pkhnz5+ErFlqsgfvn8J+dB/Yv603FrUGLdlbdsWU2EKqg0B3p76fKO8KnEyMF4Dz8DKy",
+	"BJUqUnzEPqY4bCVoC917SENntHitHFRvOfd1p5lCfgH4xiY+vuxTTd3FY2Zo3GKo3U2WTafFj/mQQyiP",
+	"xugBoWWTuZbZco8PoTs7Xbee1s4QqrhXzYcuHtZlk1vVwcnDr5ZdnKhPZzk02AuaiK2LtLOLtGwZZHAs",
+	"SrTd7738octmJ6hu5N29bfNlvUG+9XY+L29nLc56ejn9yHKXypcCq6cRhVt/SQ9vZQNIu3kplw2uyeeM",
+	"zqfXGIxd9oyUhi2ndPYstioN9hFDvquffTIB67WS3rWGdIkNwARMdmIkhDzpdvkB7I0PDnbGAMbJCu5M",
+	"1MpbZzP9apt/nbX+UPaTcx71TYf7QSpos0eObS91N7aga7eciiPmgA9RkE14MwZ3v5tKbndDLNpAWTTS",
+	"35BljPkKhFjow8ncPOO5xNeIFKjrjEr7zKqmpbIkeCS8aaZRwnd+2PVRNRP5km4jA2TjuEV/F/TnsJtv",
+	"QA6DNi7I3pvu4Y/SZfwgvkBPFQyYP5d3B4+UJnMLrHZg2Qm1QNK/SwDa/S7/6Wr9mzcsa4x/1cCFqu4e",
+	"WrBpwyMiE1v11vB/Dob/hZ6oMrx6mv1eSDkS6UXg6UHWVf2+v08CypStFdPH3q/FZs/jKn54arP/ucLz",
+	"4U1+96nMhqMpui+3WxZ5AYZ+DYNkuoE5m9tXv7TFagR6nvwEWqZ+qu4uKqYlcwukDlpmPqcZmOynLluf",
+	"+jGQur3PAmIeQbC577XU7H7qPE/iybSQ9Qo4TcaH6lUD99gjvLCD75m6sijY/a6nqrOt4J/Y3Fow9V6Y",
+	"Wu+zhtqmfKtoXv3WbHgWZkMD5nraDhfdlpsXhLCnEiNbTamPMdGI2G77hxc1y1z6IoD69CuvTnlmi++W",
+	"a7rbF500C/Vcz07+DFcvU8N9Y652BcgfAXsqqyNv8S62R6lbW6h1sUCcV5+KdkghoZs14gx/vVFSRtVj",
+	"yMfK83U19kmB5CeSlC7GfZgu0vTQNoszq02z7Rczu9/Nb5WvuzHThAvHpsmr5heFdu61phcb9y7tpba2",
+	"ts7zsHU6YrXvpkkjHD1r38vG4t8itbbKXi8TqTPQu9lLLQtx+qIR/nepCzoFyDeen6WisGW5HvZVTy3I",
+	"vEbXz8gyhfwLzEeb+PhWlWrqLuaUoXGLqHYzKptOCyXzoYvZ9NG8deo1lxycPLzgK77s6reQNAFPIvEM",
+	"UH3AVEnbA2zdDT0LqgoeiyJt97v6t6slx7xQzSw43chHXeM9tAjbjEd7YFnlWyPtORhptTjraZR9bF8s",
+	"XwqunkYWbtW9HhZWA0q7WVQ1a3T63NH59DqDNZMUSJ+F1rDllM6GUavWoB7g3LlCm77GUPaCYZ2Mt09W",
+	"Po1NZFu7i1mUvXG4NY26mEaFmbfAcj52MZHcd1+9ZlIRPQ8v9TQp5WdVa0ymrHNPIv9yKPugmw/01nrq",
+	"bj1lo1YH2ao83P1+hWx4Xy/Ntx7bJcH4D3S/6Ba3IY+OcYWeUxhWR1RvF/c+anArrPsf8WiQzOkLQO/D",
+	"rxQd1ghz5ENQZ0Jut7z0khTlzguEfnWtn66sy/hXg88mrZGN3uJYpuiK7AtxqiGExQoxwNAyjSEzGSgr",
+	"vJrJLYf9lSK2yVms8Lakh7Pyl/K/PoUKL0fiLs+w6RHcgrxdc7dYswDXv7vo6wTdFCClIUYiCcb8VVgA",
+	"C0Cs0+0tGY8hrauP8vpcGrIHhmpnDXxUma3R7UOzomYrqjvr8p954bCeBXFBQO9+l/+07IJ8WmEO1kis",
+	"qJSna3qtoK7grR7fhmGIOMdk6bzHOgRvKdOPyMYIyhIy7fzsQpfDRMBQgJTEiOukP3Z2ZMqOJmJHVryD",
+	"o+QPgDlIIOcoMu+CwiVaI/0kHxNp0vAQoerxZ37Po7aGQz2qV8q3Nws8ox2ZGrz3V+0/89rT288VUo+z",
+	"QLQtDamJGdouBy9Ac++1HOzqVV/S6ld5PiL10H2aaQj2ped6TUazzbmu+CXJY03yVh53dbvUIKMj8tR7",
+	"5H0tR13I3MDm1akdK1ID8ZNu53kD8aGv0GKcEhifKoVNDcDdLtOqVLO1K7vYlRnkLCuYD22WpTEsFcib",
+	"MF6Vts8Q5I9x0UwFjw2aiyc3CPUwO4P0uPfRVCh4h4icSBT5KJbTo+ceE5DEEBMg0Let1tPFCFZ5fBzX",
+	"uPrsflf/do0ObGVMn0mqKfmk23kuHDro0bAwQ+tpWWS92prGz8E0ruWCQZuSxRMU4gUO2zFeo2BtAf40",
+	"twB6NDv/jWeVjNsAiV57yfUryl1asE/2/16VdAuYxgLoHMEgSFkcnAQrIRJ+siuXriFBYo5ZNMQ0uP2a",
+	"EVS1XgVi0tl6g8VK7YldY3TjcXyplXCYY9zuF925Qr2WOjVmpsKdq1S3zzo12nt971xhtrvu1upsaN6j",
+	"av1SjlNt9izW3btvrsNwRyC/du4e1brXRxQqL5wLu0cT+tSFU3V2xurOVco3/EJKFniZ6mXIqV0+5Fet",
+	"+j9qcLpCcg+DpkSo9ggSN5Rdmaf8nFqzp/H6V+xWc2o+Bbdfb//fALGswn3rBwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file